@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -71,9 +72,17 @@ type FileMatch struct {
 	// Only set if requested
 	Content []byte `json:",omitempty"`
 
+	// Captures holds the values that SearchOptions.CaptureRegexp captured
+	// in the lines of the file holding content matches, in order.
+	Captures []string `json:",omitempty"`
+
 	// Checksum of the content.
 	Checksum []byte
 
+	// AlsoIn lists the other files with the same Checksum that matched. It
+	// is only set if SearchOptions.CollapseDuplicates is set.
+	AlsoIn []FileLocation `json:",omitempty"`
+
 	// Ranking; the higher, the better.
 	Score float64 `json:",omitempty"`
 
@@ -129,9 +138,42 @@ func (m *FileMatch) sizeBytes() (sz uint64) {
 	// Content
 	sz += sliceHeaderBytes + uint64(len(m.Content))
 
+	// Captures
+	sz += sliceHeaderBytes
+	for _, s := range m.Captures {
+		sz += stringHeaderBytes + uint64(len(s))
+	}
+
 	// Checksum
 	sz += sliceHeaderBytes + uint64(len(m.Checksum))
 
+	// AlsoIn
+	sz += sliceHeaderBytes
+	for _, l := range m.AlsoIn {
+		sz += l.sizeBytes()
+	}
+
+	return
+}
+
+// FileLocation identifies a file that a FileMatch was collapsed with.
+type FileLocation struct {
+	Repository string
+	FileName   string
+	Branches   []string `json:",omitempty"`
+
+	// Commit SHA1 (hex) of the repo holding the file.
+	Version string `json:",omitempty"`
+}
+
+func (l *FileLocation) sizeBytes() (sz uint64) {
+	sz += stringHeaderBytes + uint64(len(l.Repository))
+	sz += stringHeaderBytes + uint64(len(l.FileName))
+	sz += stringHeaderBytes + uint64(len(l.Version))
+	sz += sliceHeaderBytes
+	for _, s := range l.Branches {
+		sz += stringHeaderBytes + uint64(len(s))
+	}
 	return
 }
 
@@ -184,6 +226,16 @@ type ChunkMatch struct {
 	// The line number represents the index in the full file, and is 1-based. If FileName: true,
 	// this number will be 0.
 	BestLineMatch uint32
+
+	// Blame is the most recent commit that changed one of the lines of
+	// Ranges. It is only set if SearchOptions.Blame is set and blame was
+	// computed when indexing the file.
+	Blame *Blame
+
+	// SyntaxTokens classifies the tokens of Content for syntax highlighting,
+	// in order. It is only set if SearchOptions.SyntaxTokens is set and
+	// there is a lexer for the language of the file.
+	SyntaxTokens []SyntaxToken `json:",omitempty"`
 }
 
 func (cm *ChunkMatch) sizeBytes() (sz uint64) {
@@ -217,9 +269,71 @@ func (cm *ChunkMatch) sizeBytes() (sz uint64) {
 	// DebugScore
 	sz += stringHeaderBytes + uint64(len(cm.DebugScore))
 
+	// Blame
+	sz += pointerSize
+	if cm.Blame != nil {
+		sz += cm.Blame.sizeBytes()
+	}
+
+	// SyntaxTokens
+	sz += sliceHeaderBytes + uint64(len(cm.SyntaxTokens))*syntaxTokenBytes
+
 	return
 }
 
+// TokenClass is the syntactic class of a SyntaxToken.
+type TokenClass uint8
+
+const (
+	TokenKeyword TokenClass = iota + 1
+	TokenString
+	TokenComment
+	TokenIdentifier
+)
+
+var tokenClassNames = map[TokenClass]string{
+	TokenKeyword:    "keyword",
+	TokenString:     "string",
+	TokenComment:    "comment",
+	TokenIdentifier: "identifier",
+}
+
+func (c TokenClass) String() string {
+	if v, ok := tokenClassNames[c]; ok {
+		return v
+	}
+	return "unknown"
+}
+
+// MarshalText encodes c by its name, so that JSON clients don't depend on
+// the numbering.
+func (c TokenClass) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *TokenClass) UnmarshalText(b []byte) error {
+	for k, v := range tokenClassNames {
+		if v == string(b) {
+			*c = k
+			return nil
+		}
+	}
+	*c = 0
+	return nil
+}
+
+// SyntaxToken is a token of ChunkMatch.Content.
+type SyntaxToken struct {
+	// Start and End are the byte offsets of the token relative to the
+	// beginning of ChunkMatch.Content. Tokens that continue before or after
+	// the chunk, like multi-line comments, are cut off at its boundaries.
+	Start, End uint32
+
+	Class TokenClass
+}
+
+const syntaxTokenBytes uint64 = 12
+
 type Range struct {
 	// The inclusive beginning of the range.
 	Start Location
@@ -269,6 +383,11 @@ type LineMatch struct {
 	DebugScore string
 
 	LineFragments []LineFragmentMatch
+
+	// Blame is the commit that last changed the line. It is only set if
+	// SearchOptions.Blame is set and blame was computed when indexing the
+	// file.
+	Blame *Blame
 }
 
 func (lm *LineMatch) sizeBytes() (sz uint64) {
@@ -299,18 +418,49 @@ func (lm *LineMatch) sizeBytes() (sz uint64) {
 		sz += lf.sizeBytes()
 	}
 
+	// Blame
+	sz += pointerSize
+	if lm.Blame != nil {
+		sz += lm.Blame.sizeBytes()
+	}
+
 	return
 }
 
+// Blame describes the commit that last changed a line.
+type Blame struct {
+	// Commit is the ID of the commit.
+	Commit string
+
+	// Author is the author of the commit, formatted as "Name <email>".
+	Author string
+
+	// Date is the author date of the commit.
+	Date time.Time
+}
+
+func (b *Blame) sizeBytes() uint64 {
+	// Commit, Author, Date
+	return 2*stringHeaderBytes + uint64(len(b.Commit)+len(b.Author)) + 24
+}
+
 type Symbol struct {
 	Sym        string
 	Kind       string
 	Parent     string
 	ParentKind string
+
+	// SCIPSymbol is the precise symbol of the occurrence the match covers,
+	// if the document was indexed with a SCIP index. It can be passed to
+	// Searcher.References.
+	SCIPSymbol string
+
+	// Documentation is the hover documentation of SCIPSymbol.
+	Documentation string
 }
 
 func (s *Symbol) sizeBytes() uint64 {
-	return 4*stringHeaderBytes + uint64(len(s.Sym)+len(s.Kind)+len(s.Parent)+len(s.ParentKind))
+	return 6*stringHeaderBytes + uint64(len(s.Sym)+len(s.Kind)+len(s.Parent)+len(s.ParentKind)+len(s.SCIPSymbol)+len(s.Documentation))
 }
 
 // LineFragmentMatch a segment of matching text within a line.
@@ -530,6 +680,19 @@ type SearchResult struct {
 	// FragmentNames holds a repo => template string map, for
 	// the line number fragment.
 	LineFragments map[string]string
+
+	// Facets counts the matching documents. It is only set if
+	// SearchOptions.Facets is set.
+	Facets *Facets
+
+	// Captures counts the values that SearchOptions.CaptureRegexp captured
+	// in all matching documents, not just Files. Search keeps the
+	// SearchOptions.MaxCaptureValues most frequent values.
+	Captures map[string]int `json:",omitempty"`
+
+	// Cursor is set by paginated searches if there are more results than
+	// Files. Pass it in SearchOptions.Cursor to get the next page.
+	Cursor string `json:",omitempty"`
 }
 
 // SizeBytes is a best-effort estimate of the size of SearchResult in memory.
@@ -559,9 +722,106 @@ func (sr *SearchResult) SizeBytes() (sz uint64) {
 		sz += stringHeaderBytes + uint64(len(v))
 	}
 
+	// Facets
+	sz += pointerSize
+	if sr.Facets != nil {
+		sz += sr.Facets.sizeBytes()
+	}
+
+	// Captures
+	sz += mapHeaderBytes
+	for k := range sr.Captures {
+		sz += stringHeaderBytes + uint64(len(k)) + 8
+	}
+
+	sz += stringHeaderBytes + uint64(len(sr.Cursor))
+
 	return
 }
 
+// AddFacets adds the facet counts of o to sr.
+func (sr *SearchResult) AddFacets(o *Facets) {
+	if o == nil {
+		return
+	}
+	if sr.Facets == nil {
+		sr.Facets = &Facets{}
+	}
+	sr.Facets.Add(o)
+}
+
+// AddCaptures adds the capture counts of o to sr.
+func (sr *SearchResult) AddCaptures(o map[string]int) {
+	sr.Captures = addFacetCounts(sr.Captures, o)
+}
+
+// TruncateCaptures keeps the n most frequent values of sr.Captures, breaking
+// ties by value. It keeps all values if n is 0.
+func (sr *SearchResult) TruncateCaptures(n int) {
+	if n <= 0 || len(sr.Captures) <= n {
+		return
+	}
+	values := make([]string, 0, len(sr.Captures))
+	for v := range sr.Captures {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		ci, cj := sr.Captures[values[i]], sr.Captures[values[j]]
+		if ci != cj {
+			return ci > cj
+		}
+		return values[i] < values[j]
+	})
+	for _, v := range values[n:] {
+		delete(sr.Captures, v)
+	}
+}
+
+// Facets counts the documents matching a search by repository, language,
+// file category and top-level directory. Unlike Files, which are truncated
+// for display, the counts cover every document the shards matched.
+type Facets struct {
+	Repositories map[string]int
+	Languages    map[string]int
+	Categories   map[string]int
+
+	// Directories is keyed by the first directory of file names. Files in
+	// the root of a repository are not counted.
+	Directories map[string]int
+}
+
+// Add adds the counts of o to f.
+func (f *Facets) Add(o *Facets) {
+	f.Repositories = addFacetCounts(f.Repositories, o.Repositories)
+	f.Languages = addFacetCounts(f.Languages, o.Languages)
+	f.Categories = addFacetCounts(f.Categories, o.Categories)
+	f.Directories = addFacetCounts(f.Directories, o.Directories)
+}
+
+func addFacetCounts(dst, src map[string]int) map[string]int {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]int, len(src))
+	}
+	for k, v := range src {
+		dst[k] += v
+	}
+	return dst
+}
+
+func (f *Facets) sizeBytes() uint64 {
+	var sz uint64
+	for _, m := range []map[string]int{f.Repositories, f.Languages, f.Categories, f.Directories} {
+		sz += mapHeaderBytes
+		for k := range m {
+			sz += stringHeaderBytes + uint64(len(k)) + 8
+		}
+	}
+	return sz
+}
+
 // RepositoryBranch describes an indexed branch, which is a name
 // combined with a version.
 type RepositoryBranch struct {
@@ -907,12 +1167,124 @@ type RepoList struct {
 	Stats RepoStats
 }
 
+// CloneOptions configures Searcher.Clones.
+type CloneOptions struct {
+	// MinSimilarity is the smallest similarity, between 0 and 1, of the
+	// clones returned. If 0, a default of 0.5 is used.
+	MinSimilarity float64
+
+	// MaxResults limits the number of clones returned to the most similar
+	// ones. 0 means no limit.
+	MaxResults int
+}
+
+// Clone is a document that is a near duplicate of the content passed to
+// Searcher.Clones.
+type Clone struct {
+	Repository string
+	FileName   string
+	Branches   []string `json:",omitempty"`
+	Language   string   `json:",omitempty"`
+
+	// Similarity estimates the fraction of the sequences of tokens that the
+	// document shares with the content, between 0 and 1. Whitespace is
+	// ignored, so reformatted copies have similarity 1.
+	Similarity float64
+}
+
+// CloneResult is the result of Searcher.Clones.
+type CloneResult struct {
+	// Clones are ordered by descending similarity, then by repository and
+	// file name.
+	Clones []Clone
+
+	// Stats counts the shards and documents compared. Shards indexed
+	// before clone detection was added are counted as skipped.
+	Stats Stats
+}
+
+// Explanation describes how a query was evaluated, as returned by
+// Searcher.Explain. It is meant for debugging slow queries.
+type Explanation struct {
+	// Query is the query after simplification and after restricting it to
+	// the shards that contain the repositories it asks for.
+	Query string
+
+	// Shards describes the evaluation of Query by each shard that was
+	// searched.
+	Shards []ShardExplanation
+
+	// SkippedShards are the shards that weren't searched because they don't
+	// contain any of the repositories the query is restricted to.
+	SkippedShards []string
+
+	// Stats aggregates the Stats of all shards.
+	Stats Stats
+
+	// Duration is the wall time of the whole evaluation.
+	Duration time.Duration
+}
+
+// ShardExplanation describes how a single shard evaluated a query.
+type ShardExplanation struct {
+	// Shard identifies the shard, as returned by Searcher.String.
+	Shard string
+
+	// Query is the query after the shard simplified it against its
+	// repositories, branches and languages.
+	Query string
+
+	// MatchTree is the pruned match tree that was evaluated. It is empty if
+	// the shard cannot match the query.
+	MatchTree string
+
+	// Ngrams lists the ngrams that were chosen for each substring of the
+	// query.
+	Ngrams []NgramExplanation
+
+	Stats Stats
+
+	// Duration is the wall time of the evaluation in the shard.
+	Duration time.Duration
+}
+
+// NgramExplanation describes the ngrams chosen to find the candidate
+// matches of a substring.
+type NgramExplanation struct {
+	Pattern       string
+	FileName      bool
+	CaseSensitive bool
+
+	// Ngrams are the least frequent ngrams of Pattern, whose posting lists
+	// are intersected. If an ngram of Pattern doesn't occur in the shard, it
+	// is the only one.
+	Ngrams []string
+
+	// PostingListSizes are the encoded sizes in bytes of the posting lists
+	// of Ngrams, an estimate of their number of occurrences. For case
+	// insensitive patterns, they sum all case variants of the ngram.
+	PostingListSizes []uint32
+}
+
 type Searcher interface {
 	Search(ctx context.Context, q query.Q, opts *SearchOptions) (*SearchResult, error)
 
 	// List lists repositories. The query `q` can only contain
 	// query.Repo atoms.
 	List(ctx context.Context, q query.Q, opts *ListOptions) (*RepoList, error)
+
+	// References returns the references to symbol, a SCIP symbol as found
+	// in Symbol.SCIPSymbol, in all documents that were indexed with a SCIP
+	// index.
+	References(ctx context.Context, symbol string, opts *SearchOptions) (*SearchResult, error)
+
+	// Explain evaluates q like Search and describes how it did so.
+	Explain(ctx context.Context, q query.Q, opts *SearchOptions) (*Explanation, error)
+
+	// Clones returns the documents that are near duplicates of content,
+	// like copy-pasted code.
+	Clones(ctx context.Context, content []byte, opts *CloneOptions) (*CloneResult, error)
+
 	Close()
 
 	// Describe the searcher for debug messages.
@@ -997,6 +1369,52 @@ type SearchOptions struct {
 	// EXPERIMENTAL: the behavior of this flag may be changed in future versions.
 	ChunkMatches bool
 
+	// If true, LineMatches and ChunkMatches are annotated with the commit
+	// that last changed them, for shards that were indexed with blame.
+	Blame bool
+
+	// If true, literal file name atoms like file:foo match file names
+	// fuzzily, as if written file~:foo.
+	FuzzyFileNames bool
+
+	// If true, SearchResult.Facets counts the matching files by repository,
+	// language, file category and top-level directory. Commits are not
+	// counted.
+	Facets bool
+
+	// If set, the values of the first capture group of this regular
+	// expression, or of the whole match if it has no groups, are extracted
+	// from the lines of matching documents that hold a content match of the
+	// query. They are returned in FileMatch.Captures and counted in
+	// SearchResult.Captures.
+	CaptureRegexp string
+
+	// Limits SearchResult.Captures to this many of the most frequent values
+	// after collating the results. 0 means no limit.
+	MaxCaptureValues int
+
+	// If true, the results are ordered strictly by Cursor.Compare and, if
+	// MaxDocDisplayCount or MaxMatchDisplayCount cut them off,
+	// SearchResult.Cursor is set to continue with the next page. Pages hold
+	// whole files: MaxMatchDisplayCount ends a page after the file reaching
+	// it, without cutting off the matches of that file. Match limits like
+	// ShardMaxMatchCount still apply to all pages together. Streaming
+	// searches send the page as a single result.
+	Paginate bool
+
+	// If set, the search returns the page of results after the one that
+	// returned this SearchResult.Cursor. It implies Paginate.
+	Cursor string
+
+	// If true, ChunkMatch.SyntaxTokens classifies the tokens of the chunks
+	// for syntax highlighting. Needs ChunkMatches set.
+	SyntaxTokens bool
+
+	// If true, files with identical content are collapsed into the best
+	// scoring one, which lists the others in FileMatch.AlsoIn. This groups
+	// the copies of a file in forks and vendored directories.
+	CollapseDuplicates bool
+
 	// EXPERIMENTAL. If true, use text-search style scoring instead of the default
 	// scoring formula. The scoring algorithm treats each match in a file as a term
 	// and computes an approximation to BM25. When enabled, BM25 scoring is used for
@@ -1020,6 +1438,11 @@ type SearchOptions struct {
 	SpanContext map[string]string
 }
 
+// Paginated reports whether the results are paginated, see Paginate.
+func (o *SearchOptions) Paginated() bool {
+	return o.Paginate || o.Cursor != ""
+}
+
 func (o *SearchOptions) SetDefaults() {
 	if o.ShardMaxMatchCount == 0 {
 		// We cap the total number of matches, so overly broad
@@ -1072,6 +1495,7 @@ func (s *SearchOptions) String() string {
 	addInt("MaxDocDisplayCount", s.MaxDocDisplayCount)
 	addInt("MaxMatchDisplayCount", s.MaxMatchDisplayCount)
 	addInt("NumContextLines", s.NumContextLines)
+	addInt("MaxCaptureValues", s.MaxCaptureValues)
 
 	addDuration("MaxWallTime", s.MaxWallTime)
 	addDuration("FlushWallTime", s.FlushWallTime)
@@ -1079,10 +1503,23 @@ func (s *SearchOptions) String() string {
 	addBool("EstimateDocCount", s.EstimateDocCount)
 	addBool("Whole", s.Whole)
 	addBool("ChunkMatches", s.ChunkMatches)
+	addBool("Blame", s.Blame)
+	addBool("FuzzyFileNames", s.FuzzyFileNames)
+	addBool("Facets", s.Facets)
+	addBool("Paginate", s.Paginate)
+	addBool("CollapseDuplicates", s.CollapseDuplicates)
+	addBool("SyntaxTokens", s.SyntaxTokens)
 	addBool("UseBM25Scoring", s.UseBM25Scoring)
 	addBool("Trace", s.Trace)
 	addBool("DebugScore", s.DebugScore)
 
+	if s.CaptureRegexp != "" {
+		add("CaptureRegexp", strconv.Quote(s.CaptureRegexp))
+	}
+	if s.Cursor != "" {
+		add("Cursor", s.Cursor)
+	}
+
 	for k, v := range s.SpanContext {
 		add("SpanContext."+k, strconv.Quote(v))
 	}
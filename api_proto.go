@@ -46,7 +46,9 @@ func FileMatchFromProto(p *webserverv1.FileMatch) FileMatch {
 		RepositoryID:       p.GetRepositoryId(),
 		RepositoryPriority: p.GetRepositoryPriority(),
 		Content:            p.GetContent(),
+		Captures:           p.GetCaptures(),
 		Checksum:           p.GetChecksum(),
+		AlsoIn:             fileLocationsFromProto(p.GetAlsoIn()),
 		Language:           p.GetLanguage(),
 		SubRepositoryName:  p.GetSubRepositoryName(),
 		SubRepositoryPath:  p.GetSubRepositoryPath(),
@@ -76,7 +78,9 @@ func (m *FileMatch) ToProto() *webserverv1.FileMatch {
 		RepositoryId:       m.RepositoryID,
 		RepositoryPriority: m.RepositoryPriority,
 		Content:            m.Content,
+		Captures:           m.Captures,
 		Checksum:           m.Checksum,
+		AlsoIn:             fileLocationsToProto(m.AlsoIn),
 		Language:           m.Language,
 		SubRepositoryName:  m.SubRepositoryName,
 		SubRepositoryPath:  m.SubRepositoryPath,
@@ -84,6 +88,32 @@ func (m *FileMatch) ToProto() *webserverv1.FileMatch {
 	}
 }
 
+func fileLocationsFromProto(p []*webserverv1.FileLocation) []FileLocation {
+	locs := make([]FileLocation, len(p))
+	for i, l := range p {
+		locs[i] = FileLocation{
+			Repository: l.GetRepository(),
+			FileName:   l.GetFileName(),
+			Branches:   l.GetBranches(),
+			Version:    l.GetVersion(),
+		}
+	}
+	return locs
+}
+
+func fileLocationsToProto(locs []FileLocation) []*webserverv1.FileLocation {
+	p := make([]*webserverv1.FileLocation, len(locs))
+	for i, l := range locs {
+		p[i] = &webserverv1.FileLocation{
+			Repository: l.Repository,
+			FileName:   l.FileName,
+			Branches:   l.Branches,
+			Version:    l.Version,
+		}
+	}
+	return p
+}
+
 func ChunkMatchFromProto(p *webserverv1.ChunkMatch) ChunkMatch {
 	ranges := make([]Range, len(p.GetRanges()))
 	for i, r := range p.GetRanges() {
@@ -104,6 +134,8 @@ func ChunkMatchFromProto(p *webserverv1.ChunkMatch) ChunkMatch {
 		Score:         p.GetScore(),
 		BestLineMatch: p.GetBestLineMatch(),
 		DebugScore:    p.GetDebugScore(),
+		Blame:         BlameFromProto(p.GetBlame()),
+		SyntaxTokens:  syntaxTokensFromProto(p.GetSyntaxTokens()),
 	}
 }
 
@@ -127,9 +159,76 @@ func (cm *ChunkMatch) ToProto() *webserverv1.ChunkMatch {
 		Score:         cm.Score,
 		BestLineMatch: cm.BestLineMatch,
 		DebugScore:    cm.DebugScore,
+		Blame:         cm.Blame.ToProto(),
+		SyntaxTokens:  syntaxTokensToProto(cm.SyntaxTokens),
+	}
+}
+
+func syntaxTokensFromProto(p []*webserverv1.SyntaxToken) []SyntaxToken {
+	if p == nil {
+		return nil
+	}
+	toks := make([]SyntaxToken, len(p))
+	for i, t := range p {
+		toks[i] = SyntaxToken{
+			Start: t.GetStart(),
+			End:   t.GetEnd(),
+			Class: TokenClassFromProto(t.GetClass()),
+		}
+	}
+	return toks
+}
+
+func syntaxTokensToProto(toks []SyntaxToken) []*webserverv1.SyntaxToken {
+	if toks == nil {
+		return nil
+	}
+	p := make([]*webserverv1.SyntaxToken, len(toks))
+	for i, t := range toks {
+		p[i] = &webserverv1.SyntaxToken{
+			Start: t.Start,
+			End:   t.End,
+			Class: t.Class.ToProto(),
+		}
+	}
+	return p
+}
+
+func TokenClassFromProto(p webserverv1.TokenClass) TokenClass {
+	switch p {
+	case webserverv1.TokenClass_TOKEN_CLASS_KEYWORD:
+		return TokenKeyword
+	case webserverv1.TokenClass_TOKEN_CLASS_STRING:
+		return TokenString
+	case webserverv1.TokenClass_TOKEN_CLASS_COMMENT:
+		return TokenComment
+	case webserverv1.TokenClass_TOKEN_CLASS_IDENTIFIER:
+		return TokenIdentifier
+	default:
+		return TokenClass(0)
 	}
 }
 
+func (c TokenClass) ToProto() webserverv1.TokenClass {
+	switch c {
+	case TokenKeyword:
+		return webserverv1.TokenClass_TOKEN_CLASS_KEYWORD
+	case TokenString:
+		return webserverv1.TokenClass_TOKEN_CLASS_STRING
+	case TokenComment:
+		return webserverv1.TokenClass_TOKEN_CLASS_COMMENT
+	case TokenIdentifier:
+		return webserverv1.TokenClass_TOKEN_CLASS_IDENTIFIER
+	default:
+		return webserverv1.TokenClass_TOKEN_CLASS_UNKNOWN_UNSPECIFIED
+	}
+}
+
+// Generate valid token classes for quickchecks
+func (c TokenClass) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TokenClass(rand.Intn(len(tokenClassNames) + 1)))
+}
+
 func RangeFromProto(p *webserverv1.Range) Range {
 	return Range{
 		Start: LocationFromProto(p.GetStart()),
@@ -177,6 +276,7 @@ func LineMatchFromProto(p *webserverv1.LineMatch) LineMatch {
 		Score:         p.GetScore(),
 		DebugScore:    p.GetDebugScore(),
 		LineFragments: lineFragments,
+		Blame:         BlameFromProto(p.GetBlame()),
 	}
 }
 
@@ -197,6 +297,31 @@ func (lm *LineMatch) ToProto() *webserverv1.LineMatch {
 		Score:         lm.Score,
 		DebugScore:    lm.DebugScore,
 		LineFragments: fragments,
+		Blame:         lm.Blame.ToProto(),
+	}
+}
+
+func BlameFromProto(p *webserverv1.Blame) *Blame {
+	if p == nil {
+		return nil
+	}
+
+	return &Blame{
+		Commit: p.GetCommit(),
+		Author: p.GetAuthor(),
+		Date:   p.GetDate().AsTime(),
+	}
+}
+
+func (b *Blame) ToProto() *webserverv1.Blame {
+	if b == nil {
+		return nil
+	}
+
+	return &webserverv1.Blame{
+		Commit: b.Commit,
+		Author: b.Author,
+		Date:   timestamppb.New(b.Date),
 	}
 }
 
@@ -206,10 +331,12 @@ func SymbolFromProto(p *webserverv1.SymbolInfo) *Symbol {
 	}
 
 	return &Symbol{
-		Sym:        p.GetSym(),
-		Kind:       p.GetKind(),
-		Parent:     p.GetParent(),
-		ParentKind: p.GetParentKind(),
+		Sym:           p.GetSym(),
+		Kind:          p.GetKind(),
+		Parent:        p.GetParent(),
+		ParentKind:    p.GetParentKind(),
+		SCIPSymbol:    p.GetScipSymbol(),
+		Documentation: p.GetDocumentation(),
 	}
 }
 
@@ -219,10 +346,12 @@ func (s *Symbol) ToProto() *webserverv1.SymbolInfo {
 	}
 
 	return &webserverv1.SymbolInfo{
-		Sym:        s.Sym,
-		Kind:       s.Kind,
-		Parent:     s.Parent,
-		ParentKind: s.ParentKind,
+		Sym:           s.Sym,
+		Kind:          s.Kind,
+		Parent:        s.Parent,
+		ParentKind:    s.ParentKind,
+		ScipSymbol:    s.SCIPSymbol,
+		Documentation: s.Documentation,
 	}
 }
 
@@ -374,6 +503,10 @@ func SearchResultFromProto(p *webserverv1.SearchResponse, repoURLs, lineFragment
 
 		RepoURLs:      repoURLs,
 		LineFragments: lineFragments,
+
+		Facets:   FacetsFromProto(p.GetFacets()),
+		Captures: capturesFromProto(p.GetCaptures()),
+		Cursor:   p.GetCursor(),
 	}
 }
 
@@ -392,7 +525,84 @@ func (sr *SearchResult) ToProto() *webserverv1.SearchResponse {
 		Progress: sr.Progress.ToProto(),
 
 		Files: files,
+
+		Facets:   sr.Facets.ToProto(),
+		Captures: capturesToProto(sr.Captures),
+		Cursor:   sr.Cursor,
+	}
+}
+
+// capturesFromProto converts capture counts. Unlike facet counts, it keeps
+// empty maps so that the result of a search that captured nothing is
+// distinguishable from one that didn't capture.
+func capturesFromProto(p map[string]int64) map[string]int {
+	if p == nil {
+		return nil
+	}
+	m := make(map[string]int, len(p))
+	for k, v := range p {
+		m[k] = int(v)
+	}
+	return m
+}
+
+func capturesToProto(m map[string]int) map[string]int64 {
+	if m == nil {
+		return nil
+	}
+	p := make(map[string]int64, len(m))
+	for k, v := range m {
+		p[k] = int64(v)
+	}
+	return p
+}
+
+func FacetsFromProto(p *webserverv1.Facets) *Facets {
+	if p == nil {
+		return nil
+	}
+
+	return &Facets{
+		Repositories: facetCountsFromProto(p.GetRepositories()),
+		Languages:    facetCountsFromProto(p.GetLanguages()),
+		Categories:   facetCountsFromProto(p.GetCategories()),
+		Directories:  facetCountsFromProto(p.GetDirectories()),
+	}
+}
+
+func (f *Facets) ToProto() *webserverv1.Facets {
+	if f == nil {
+		return nil
 	}
+
+	return &webserverv1.Facets{
+		Repositories: facetCountsToProto(f.Repositories),
+		Languages:    facetCountsToProto(f.Languages),
+		Categories:   facetCountsToProto(f.Categories),
+		Directories:  facetCountsToProto(f.Directories),
+	}
+}
+
+func facetCountsFromProto(p map[string]int64) map[string]int {
+	if len(p) == 0 {
+		return nil
+	}
+	m := make(map[string]int, len(p))
+	for k, v := range p {
+		m[k] = int(v)
+	}
+	return m
+}
+
+func facetCountsToProto(m map[string]int) map[string]int64 {
+	if len(m) == 0 {
+		return nil
+	}
+	p := make(map[string]int64, len(m))
+	for k, v := range m {
+		p[k] = int64(v)
+	}
+	return p
 }
 
 func (sr *SearchResult) ToStreamProto() *webserverv1.StreamSearchResponse {
@@ -653,6 +863,88 @@ func (r *RepoList) ToProto() *webserverv1.ListResponse {
 	}
 }
 
+func ExplanationFromProto(p *webserverv1.ExplainResponse) *Explanation {
+	var shards []ShardExplanation
+	for _, sp := range p.GetShards() {
+		shards = append(shards, ShardExplanationFromProto(sp))
+	}
+
+	return &Explanation{
+		Query:         p.GetQuery(),
+		Shards:        shards,
+		SkippedShards: p.GetSkippedShards(),
+		Stats:         StatsFromProto(p.GetStats()),
+		Duration:      p.GetDuration().AsDuration(),
+	}
+}
+
+func (e *Explanation) ToProto() *webserverv1.ExplainResponse {
+	var shards []*webserverv1.ShardExplanation
+	for _, s := range e.Shards {
+		shards = append(shards, s.ToProto())
+	}
+
+	return &webserverv1.ExplainResponse{
+		Query:         e.Query,
+		Shards:        shards,
+		SkippedShards: e.SkippedShards,
+		Stats:         e.Stats.ToProto(),
+		Duration:      durationpb.New(e.Duration),
+	}
+}
+
+func ShardExplanationFromProto(p *webserverv1.ShardExplanation) ShardExplanation {
+	var ngrams []NgramExplanation
+	for _, np := range p.GetNgrams() {
+		ngrams = append(ngrams, NgramExplanationFromProto(np))
+	}
+
+	return ShardExplanation{
+		Shard:     p.GetShard(),
+		Query:     p.GetQuery(),
+		MatchTree: p.GetMatchTree(),
+		Ngrams:    ngrams,
+		Stats:     StatsFromProto(p.GetStats()),
+		Duration:  p.GetDuration().AsDuration(),
+	}
+}
+
+func (s *ShardExplanation) ToProto() *webserverv1.ShardExplanation {
+	var ngrams []*webserverv1.NgramExplanation
+	for _, n := range s.Ngrams {
+		ngrams = append(ngrams, n.ToProto())
+	}
+
+	return &webserverv1.ShardExplanation{
+		Shard:     s.Shard,
+		Query:     s.Query,
+		MatchTree: s.MatchTree,
+		Ngrams:    ngrams,
+		Stats:     s.Stats.ToProto(),
+		Duration:  durationpb.New(s.Duration),
+	}
+}
+
+func NgramExplanationFromProto(p *webserverv1.NgramExplanation) NgramExplanation {
+	return NgramExplanation{
+		Pattern:          p.GetPattern(),
+		FileName:         p.GetFileName(),
+		CaseSensitive:    p.GetCaseSensitive(),
+		Ngrams:           p.GetNgrams(),
+		PostingListSizes: p.GetPostingListSizes(),
+	}
+}
+
+func (n *NgramExplanation) ToProto() *webserverv1.NgramExplanation {
+	return &webserverv1.NgramExplanation{
+		Pattern:          n.Pattern,
+		FileName:         n.FileName,
+		CaseSensitive:    n.CaseSensitive,
+		Ngrams:           n.Ngrams,
+		PostingListSizes: n.PostingListSizes,
+	}
+}
+
 func (l *ListOptions) ToProto() *webserverv1.ListOptions {
 	if l == nil {
 		return nil
@@ -703,6 +995,15 @@ func SearchOptionsFromProto(p *webserverv1.SearchOptions) *SearchOptions {
 		MaxMatchDisplayCount:   int(p.GetMaxMatchDisplayCount()),
 		NumContextLines:        int(p.GetNumContextLines()),
 		ChunkMatches:           p.GetChunkMatches(),
+		Blame:                  p.GetBlame(),
+		FuzzyFileNames:         p.GetFuzzyFileNames(),
+		Facets:                 p.GetFacets(),
+		CaptureRegexp:          p.GetCaptureRegexp(),
+		MaxCaptureValues:       int(p.GetMaxCaptureValues()),
+		Paginate:               p.GetPaginate(),
+		Cursor:                 p.GetCursor(),
+		CollapseDuplicates:     p.GetCollapseDuplicates(),
+		SyntaxTokens:           p.GetSyntaxTokens(),
 		Trace:                  p.GetTrace(),
 		DebugScore:             p.GetDebugScore(),
 		UseBM25Scoring:         p.GetUseBm25Scoring(),
@@ -726,6 +1027,15 @@ func (s *SearchOptions) ToProto() *webserverv1.SearchOptions {
 		MaxMatchDisplayCount:   int64(s.MaxMatchDisplayCount),
 		NumContextLines:        int64(s.NumContextLines),
 		ChunkMatches:           s.ChunkMatches,
+		Blame:                  s.Blame,
+		FuzzyFileNames:         s.FuzzyFileNames,
+		Facets:                 s.Facets,
+		CaptureRegexp:          s.CaptureRegexp,
+		MaxCaptureValues:       int64(s.MaxCaptureValues),
+		Paginate:               s.Paginate,
+		Cursor:                 s.Cursor,
+		CollapseDuplicates:     s.CollapseDuplicates,
+		SyntaxTokens:           s.SyntaxTokens,
 		Trace:                  s.Trace,
 		DebugScore:             s.DebugScore,
 		UseBm25Scoring:         s.UseBM25Scoring,
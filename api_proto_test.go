@@ -89,6 +89,17 @@ func TestProtoRoundtrip(t *testing.T) {
 		}
 	})
 
+	t.Run("Blame", func(t *testing.T) {
+		f := func(f1 *Blame) bool {
+			p1 := f1.ToProto()
+			f2 := BlameFromProto(p1)
+			return reflect.DeepEqual(f1, f2)
+		}
+		if err := quick.Check(f, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
 	t.Run("Symbol", func(t *testing.T) {
 		f := func(f1 *Symbol) bool {
 			p1 := f1.ToProto()
@@ -175,6 +186,21 @@ func TestProtoRoundtrip(t *testing.T) {
 		})
 	})
 
+	t.Run("Explanation", func(t *testing.T) {
+		f := func(f1 Explanation) bool {
+			p1 := f1.ToProto()
+			f2 := ExplanationFromProto(p1)
+			if diff := cmp.Diff(&f1, f2, cmpopts.EquateEmpty()); diff != "" {
+				fmt.Printf("got diff: %s", diff)
+				return false
+			}
+			return true
+		}
+		if err := quick.Check(f, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
 	t.Run("Repository", func(t *testing.T) {
 		f := func(f1 *Repository) bool {
 			p1 := f1.ToProto()
@@ -390,6 +416,43 @@ func (*Repository) Generate(rng *rand.Rand, _ int) reflect.Value {
 	return reflect.ValueOf(v)
 }
 
+func (*Blame) Generate(rng *rand.Rand, _ int) reflect.Value {
+	if rng.Intn(2) == 0 {
+		return reflect.ValueOf((*Blame)(nil))
+	}
+	var b Blame
+	return reflect.ValueOf(&Blame{
+		Commit: gen(b.Commit, rng),
+		Author: gen(b.Author, rng),
+		Date:   time.Unix(rng.Int63n(1<<32), 0).UTC(),
+	})
+}
+
+func (*Facets) Generate(rng *rand.Rand, _ int) reflect.Value {
+	if rng.Intn(2) == 0 {
+		return reflect.ValueOf((*Facets)(nil))
+	}
+	// Empty maps don't survive the proto roundtrip, so we generate nil or
+	// non-empty maps.
+	counts := func() map[string]int {
+		n := rng.Intn(3)
+		if n == 0 {
+			return nil
+		}
+		m := make(map[string]int, n)
+		for range n {
+			m[gen("", rng)] = rng.Intn(1000)
+		}
+		return m
+	}
+	return reflect.ValueOf(&Facets{
+		Repositories: counts(),
+		Languages:    counts(),
+		Categories:   counts(),
+		Directories:  counts(),
+	})
+}
+
 func (RepoListField) Generate(rng *rand.Rand, _ int) reflect.Value {
 	if rng.Intn(2) == 0 {
 		return reflect.ValueOf(RepoListField(RepoListFieldRepos))
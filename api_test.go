@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/grafana/regexp"
 )
 
@@ -89,14 +90,14 @@ func TestSizeBytesSearchResult(t *testing.T) {
 	sr := SearchResult{
 		Stats:    Stats{},    // 129 bytes
 		Progress: Progress{}, // 16 bytes
-		Files: []FileMatch{{ // 24 bytes + 460 bytes
+		Files: []FileMatch{{ // 24 bytes + 540 bytes
 			Score:       0,   // 8 bytes
 			Debug:       "",  // 16 bytes
 			FileName:    "",  // 16 bytes
 			Repository:  "",  // 16 bytes
 			Branches:    nil, // 24 bytes
 			LineMatches: nil, // 24 bytes
-			ChunkMatches: []ChunkMatch{{ // 24 bytes + 208 bytes (see TestSizeByteChunkMatches)
+			ChunkMatches: []ChunkMatch{{ // 24 bytes + 272 bytes (see TestSizeByteChunkMatches)
 				Content:      []byte("foo"),
 				ContentStart: Location{},
 				FileName:     false,
@@ -108,7 +109,9 @@ func TestSizeBytesSearchResult(t *testing.T) {
 			RepositoryID:       0,   // 4 bytes
 			RepositoryPriority: 0,   // 8 bytes
 			Content:            nil, // 24 bytes
+			Captures:           nil, // 24 bytes
 			Checksum:           nil, // 24 bytes
+			AlsoIn:             nil, // 24 bytes
 			Language:           "",  // 16 bytes
 			SubRepositoryName:  "",  // 16 bytes
 			SubRepositoryPath:  "",  // 16 bytes
@@ -116,26 +119,46 @@ func TestSizeBytesSearchResult(t *testing.T) {
 		}},
 		RepoURLs:      nil, // 48 bytes
 		LineFragments: nil, // 48 bytes
+		Facets:        nil, // 8 bytes
+		Captures:      nil, // 48 bytes
+		Cursor:        "",  // 16 bytes
 	}
 
-	var wantBytes uint64 = 725
+	var wantBytes uint64 = 909
 	if sr.SizeBytes() != wantBytes {
 		t.Fatalf("want %d, got %d", wantBytes, sr.SizeBytes())
 	}
 }
 
+func TestTruncateCaptures(t *testing.T) {
+	sr := SearchResult{Captures: map[string]int{"a": 1, "b": 3, "c": 2, "d": 2}}
+
+	sr.TruncateCaptures(0)
+	if len(sr.Captures) != 4 {
+		t.Fatalf("got %v, want all captures for n=0", sr.Captures)
+	}
+
+	// Ties are broken by value.
+	sr.TruncateCaptures(2)
+	if d := cmp.Diff(map[string]int{"b": 3, "c": 2}, sr.Captures); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
 func TestSizeBytesChunkMatches(t *testing.T) {
 	cm := ChunkMatch{
 		Content:      []byte("foo"), // 24 + 3 bytes
 		ContentStart: Location{},    // 12 bytes
 		FileName:     false,         // 1 byte
 		Ranges:       []Range{{}},   // 24 bytes (slice header) + 24 bytes (content)
-		SymbolInfo:   []*Symbol{{}}, // 24 bytes (slice header) + 4 * 16 bytes (string header) + 8 bytes (pointer)
+		SymbolInfo:   []*Symbol{{}}, // 24 bytes (slice header) + 6 * 16 bytes (string header) + 8 bytes (pointer)
 		Score:        0,             // 8 byte
 		DebugScore:   "",            // 16 bytes (string header)
+		Blame:        nil,           // 8 bytes (pointer)
+		SyntaxTokens: nil,           // 24 bytes (slice header)
 	}
 
-	var wantBytes uint64 = 208
+	var wantBytes uint64 = 272
 	if cm.sizeBytes() != wantBytes {
 		t.Fatalf("want %d, got %d", wantBytes, cm.sizeBytes())
 	}
@@ -147,10 +170,10 @@ func TestMatchSize(t *testing.T) {
 		size int
 	}{{
 		v:    FileMatch{},
-		size: 256,
+		size: 304,
 	}, {
 		v:    ChunkMatch{},
-		size: 120,
+		size: 152,
 	}}
 	for _, c := range cases {
 		got := reflect.TypeOf(c.v).Size()
@@ -183,6 +206,8 @@ func TestSearchOptions_String(t *testing.T) {
 			f.SetInt(1)
 		case reflect.Float64:
 			f.SetFloat(1)
+		case reflect.String:
+			f.SetString("value")
 		case reflect.Map:
 			// Only map is SpanContext
 			f.Set(reflect.ValueOf(map[string]string{"key": "value"}))
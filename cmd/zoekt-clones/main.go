@@ -0,0 +1,124 @@
+// Command zoekt-clones finds near duplicates of a file or snippet, like
+// copy-pasted code, in an index directory or shard.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+	"github.com/sourcegraph/zoekt/search"
+)
+
+func loadShard(fn string) (zoekt.Searcher, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	iFile, err := index.NewIndexFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := index.NewSearcher(iFile)
+	if err != nil {
+		iFile.Close()
+		return nil, fmt.Errorf("NewSearcher(%s): %v", fn, err)
+	}
+	return s, nil
+}
+
+// readContent reads the file named by args, or stdin if args is empty.
+func readContent(args []string) ([]byte, error) {
+	switch len(args) {
+	case 0:
+		return io.ReadAll(os.Stdin)
+	case 1:
+		return os.ReadFile(args[0])
+	default:
+		return nil, fmt.Errorf("got %d files, want at most 1", len(args))
+	}
+}
+
+func displayClones(w io.Writer, cr *zoekt.CloneResult, jsonl bool) error {
+	if jsonl {
+		enc := json.NewEncoder(w)
+		for _, c := range cr.Clones {
+			if err := enc.Encode(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, c := range cr.Clones {
+		if _, err := fmt.Fprintf(w, "%.2f\t%s/%s\n", c.Similarity, c.Repository, c.FileName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	shard := flag.String("shard", "", "search in a specific shard")
+	indexDir := flag.String("index_dir",
+		filepath.Join(os.Getenv("HOME"), ".zoekt"), "search for index files in `directory`")
+	minSimilarity := flag.Float64("min_similarity", index.DefaultMinCloneSimilarity, "only print clones at least this similar, between 0 and 1")
+	maxResults := flag.Int("n", 50, "print at most this many clones, 0 for all")
+	jsonl := flag.Bool("jsonl", false, "print clones in jsonl format")
+	verbose := flag.Bool("v", false, "print some background data")
+
+	flag.Usage = func() {
+		name := os.Args[0]
+		fmt.Fprintf(os.Stderr, "Usage:\n\n  %s [option] [FILE]\n\n"+
+			"Prints the near duplicates of FILE, or of stdin, with their similarity.\n"+
+			"for example\n\n  %s -min_similarity 0.8 api.go\n\n", name, name)
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\n")
+	}
+	flag.Parse()
+
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	content, err := readContent(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var searcher zoekt.Searcher
+	if *shard != "" {
+		searcher, err = loadShard(*shard)
+	} else {
+		searcher, err = search.NewDirectorySearcher(*indexDir)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer searcher.Close()
+
+	cr, err := searcher.Clones(context.Background(), content, &zoekt.CloneOptions{
+		MinSimilarity: *minSimilarity,
+		MaxResults:    *maxResults,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := displayClones(os.Stdout, cr, *jsonl); err != nil {
+		log.Fatal(err)
+	}
+	if *verbose {
+		log.Printf("stats: %+v", cr.Stats)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"runtime/pprof"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"go.uber.org/automaxprocs/maxprocs"
@@ -45,6 +46,12 @@ func run() int {
 		"It also affects name if the indexed repository is under this directory.")
 	isDelta := flag.Bool("delta", false, "whether we should use delta build")
 	deltaShardNumberFallbackThreshold := flag.Uint64("delta_threshold", 0, "upper limit on the number of preexisting shards that can exist before attempting a delta build (0 to disable fallback behavior)")
+	workTree := flag.Bool("worktree", false, "also index the working tree, including uncommitted changes and untracked files, as branch "+gitindex.DefaultWorkTreeBranch)
+	watch := flag.Bool("watch", false, "keep running and reindex the working tree whenever it changes (implies -worktree)")
+	watchDebounce := flag.Duration("watch_debounce", 500*time.Millisecond, "debounce interval for -watch")
+	commits := flag.Bool("commits", false, "also index the history of the branches, searchable with type:commit")
+	maxCommits := flag.Int("max_commits", 0, "maximum number of commits to index with -commits, newest first (0 for no limit)")
+	blame := flag.Bool("blame", false, "record the commit that last changed each line, for SearchOptions.Blame and modified: queries")
 	languageMap := flag.String("language_map", "", "a mapping between a language and its ctags processor (a:0,b:3).")
 
 	cpuProfile := flag.String("cpu_profile", "", "write cpu profile to `file`")
@@ -118,6 +125,18 @@ func run() int {
 		}
 	}
 
+	if *watch {
+		*workTree = true
+		if len(gitRepos) != 1 {
+			log.Fatal("-watch requires exactly one repository")
+		}
+	}
+
+	var workTreeBranch string
+	if *workTree {
+		workTreeBranch = gitindex.DefaultWorkTreeBranch
+	}
+
 	profiler.Init("zoekt-git-index")
 	exitStatus := 0
 	for dir, name := range gitRepos {
@@ -132,6 +151,18 @@ func run() int {
 			Branches:                          branches,
 			RepoDir:                           dir,
 			DeltaShardNumberFallbackThreshold: *deltaShardNumberFallbackThreshold,
+			WorkTreeBranch:                    workTreeBranch,
+			IndexCommits:                      *commits,
+			MaxCommits:                        *maxCommits,
+			Blame:                             *blame,
+		}
+
+		if *watch {
+			if err := watchWorkTree(gitOpts, *watchDebounce); err != nil {
+				log.Printf("watchWorkTree(%s): %v", dir, err)
+				exitStatus = 1
+			}
+			continue
 		}
 
 		if _, err := gitindex.IndexGitRepo(gitOpts); err != nil {
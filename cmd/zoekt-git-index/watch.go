@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/sourcegraph/zoekt/gitindex"
+	"github.com/sourcegraph/zoekt/ignore"
+	"github.com/sourcegraph/zoekt/internal/fswatch"
+)
+
+// watchWorkTree indexes the repository described by opts, and then reindexes
+// it whenever its working tree, index or refs change. Changes are debounced,
+// and a reindex is skipped if the indexed branches didn't change.
+func watchWorkTree(opts gitindex.Options, debounce time.Duration) error {
+	if debounce <= 0 {
+		return fmt.Errorf("watch_debounce must be greater than zero")
+	}
+	if opts.WorkTreeBranch == "" {
+		return fmt.Errorf("watching requires indexing the working tree")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	gitIgnore, err := ignore.NewGitMatcher(opts.RepoDir)
+	if err != nil {
+		return err
+	}
+	root := gitIgnore.Root()
+	// In linked worktrees, HEAD and the index are in the git directory of the
+	// worktree, and the refs are in the common git directory.
+	gitDir, commonDir := gitIgnore.GitDir(), gitIgnore.CommonDir()
+	if gitDir == "" {
+		return fmt.Errorf("%s is not in a git working tree", opts.RepoDir)
+	}
+	refsDir := filepath.Join(commonDir, "refs")
+
+	if err := addWatchTree(watcher, root, gitIgnore); err != nil {
+		return err
+	}
+	// Commits and checkouts show up as changes of HEAD, the index and the
+	// refs, but the rest of the git directories is not of interest.
+	for _, dir := range []string{gitDir, commonDir} {
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("adding watch for %s: %w", dir, err)
+		}
+	}
+	if err := fswatch.AddTree(watcher, refsDir, nil); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	reindex := func() error {
+		ok, err := gitindex.IndexGitRepo(opts)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			log.Printf("index of %s is up to date", root)
+		}
+		return nil
+	}
+
+	log.Printf("watching working tree %s", root)
+	if err := reindex(); err != nil {
+		return err
+	}
+
+	loop := &fswatch.Loop{
+		Watcher:  watcher,
+		Debounce: debounce,
+		Event: func(event fsnotify.Event) string {
+			inGitDir := fswatch.Within(event.Name, gitDir) || fswatch.Within(event.Name, commonDir)
+			if !watchEventRelevant(event, root, inGitDir, gitIgnore) {
+				return ""
+			}
+
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					// Below the git directories, only refs are watched.
+					var err error
+					if !inGitDir {
+						err = addWatchTree(watcher, event.Name, gitIgnore)
+					} else if fswatch.Within(event.Name, refsDir) {
+						err = fswatch.AddTree(watcher, event.Name, nil)
+					}
+					if err != nil {
+						log.Printf("failed to add watcher for %s: %v", event.Name, err)
+					}
+				}
+			}
+			if !inGitDir && filepath.Base(event.Name) == ignore.GitIgnoreFile {
+				if rel, err := filepath.Rel(root, filepath.Dir(event.Name)); err == nil {
+					gitIgnore.Invalidate(filepath.ToSlash(rel))
+				}
+			}
+			return event.String()
+		},
+		Start: func() func() error {
+			return reindex
+		},
+	}
+	return loop.Run()
+}
+
+// watchEventRelevant returns true if event may change the content of the
+// working tree branch. inGitDir is set for events below the git directories.
+func watchEventRelevant(event fsnotify.Event, root string, inGitDir bool, gitIgnore *ignore.GitMatcher) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+
+	if inGitDir {
+		// Lock files come and go on every git operation. The operation itself
+		// is visible once the lock file is renamed into place.
+		return !strings.HasSuffix(event.Name, ".lock")
+	}
+
+	rel, err := filepath.Rel(root, event.Name)
+	if err != nil {
+		return false
+	}
+
+	// The path may be gone already, so we can't tell whether it was a
+	// directory. Ignored directories aren't watched, so only their direct
+	// children can produce events here.
+	info, err := os.Stat(event.Name)
+	isDir := err == nil && info.IsDir()
+	return !gitIgnore.Match(filepath.ToSlash(rel), isDir)
+}
+
+// addWatchTree watches dir and all its subdirectories, skipping .git
+// directories and directories ignored by gitIgnore.
+func addWatchTree(watcher *fsnotify.Watcher, dir string, gitIgnore *ignore.GitMatcher) error {
+	return fswatch.AddTree(watcher, dir, func(path string) bool {
+		if filepath.Base(path) == ".git" {
+			return true
+		}
+		rel, err := filepath.Rel(gitIgnore.Root(), path)
+		return err == nil && gitIgnore.Match(filepath.ToSlash(rel), true)
+	})
+}
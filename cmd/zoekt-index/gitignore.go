@@ -0,0 +1,80 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/sourcegraph/zoekt/ignore"
+)
+
+// gitIgnoreFilter applies the .gitignore rules of the working trees that
+// contain a set of indexed paths. A nil filter ignores nothing.
+type gitIgnoreFilter struct {
+	matchers []*ignore.GitMatcher
+}
+
+// newGitIgnoreFilter returns a filter for the working trees containing
+// paths, or nil if enabled is false.
+func newGitIgnoreFilter(paths []string, enabled bool) (*gitIgnoreFilter, error) {
+	if !enabled {
+		return nil, nil
+	}
+
+	f := &gitIgnoreFilter{}
+	seen := map[string]struct{}{}
+	for _, path := range paths {
+		m, err := ignore.NewGitMatcher(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[m.Root()]; ok {
+			continue
+		}
+		seen[m.Root()] = struct{}{}
+		f.matchers = append(f.matchers, m)
+	}
+	return f, nil
+}
+
+// ignored returns true if git ignores the absolute path.
+func (f *gitIgnoreFilter) ignored(path string, isDir bool) bool {
+	if f == nil {
+		return false
+	}
+
+	m, rel := f.matcherFor(path)
+	if m == nil {
+		return false
+	}
+	return m.Match(rel, isDir)
+}
+
+// invalidate must be called when the .gitignore file at the absolute path
+// changes. It returns true if the file belongs to one of the working trees.
+func (f *gitIgnoreFilter) invalidate(path string) bool {
+	if f == nil || filepath.Base(path) != ignore.GitIgnoreFile {
+		return false
+	}
+
+	m, rel := f.matcherFor(filepath.Dir(path))
+	if m == nil {
+		return false
+	}
+	m.Invalidate(rel)
+	return true
+}
+
+// matcherFor returns the matcher of the working tree containing path, and
+// path relative to its root.
+func (f *gitIgnoreFilter) matcherFor(path string) (*ignore.GitMatcher, string) {
+	for _, m := range f.matchers {
+		if !pathWithinRoots(path, []string{m.Root()}) {
+			continue
+		}
+		rel, err := filepath.Rel(m.Root(), path)
+		if err != nil {
+			continue
+		}
+		return m, filepath.ToSlash(rel)
+	}
+	return nil, ""
+}
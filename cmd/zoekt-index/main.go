@@ -43,6 +43,7 @@ type fileAggregator struct {
 	sizeMax    int64
 	sink       chan fileInfo
 	fileExts   map[string]struct{} // Map of allowed file extensions
+	gitIgnore  *gitIgnoreFilter    // Skips paths ignored by git, if set
 }
 
 // IndexConfig represents a JSON configuration for indexing
@@ -61,6 +62,8 @@ type IndexConfig struct {
 	IndexDir string `json:"index_dir"`
 	// Parallelism factor for indexing
 	Parallelism int `json:"parallelism"`
+	// Skip files ignored by .gitignore, .git/info/exclude and the global excludes file
+	UseGitIgnore bool `json:"use_gitignore"`
 }
 
 func (a *fileAggregator) add(path string, info os.FileInfo, err error) error {
@@ -73,6 +76,9 @@ func (a *fileAggregator) add(path string, info os.FileInfo, err error) error {
 		if _, ok := a.ignoreDirs[base]; ok {
 			return filepath.SkipDir
 		}
+		if a.gitIgnore.ignored(path, true) {
+			return filepath.SkipDir
+		}
 	}
 
 	if info.Mode().IsRegular() {
@@ -83,6 +89,9 @@ func (a *fileAggregator) add(path string, info os.FileInfo, err error) error {
 				return nil // Skip files with extensions not in the whitelist
 			}
 		}
+		if a.gitIgnore.ignored(path, false) {
+			return nil
+		}
 
 		a.sink <- fileInfo{path, info.Size()}
 	}
@@ -236,6 +245,9 @@ func listConfigs() error {
 		}
 
 		fmt.Printf("  Ignore: %s\n", config.IgnoreDirs)
+		if config.UseGitIgnore {
+			fmt.Printf("  Git Ignore: enabled\n")
+		}
 
 		// Show file extensions if specified
 		if config.FileExtensions != "" {
@@ -269,9 +281,12 @@ func main() {
 	cpuProfile := flag.String("cpu_profile", "", "write cpu profile to file")
 	ignoreDirs := flag.String("ignore_dirs", ".git,.hg,.svn", "comma separated list of directories to ignore.")
 	metaFile := flag.String("meta", "", "path to .meta JSON file with repository description")
+	useGitIgnore := flag.Bool("gitignore", false, "skip files ignored by .gitignore, .git/info/exclude and the global git excludes file")
 	// Add output index directory flag with default to our custom location
 	indexDir := flag.String("index_dir", "", "directory to write index files (defaults to ~/.zoekt/indexdb)")
 	watchDebounce := flag.Duration("watch_debounce", 3*time.Second, "delay before reindexing after filesystem changes in watch mode")
+	watchCompactShards := flag.Int("watch_compact_shards", 16, "in watch mode, compact a repository's shards once it has more than this many delta shards (0 disables)")
+	watchCompactTombstones := flag.Int("watch_compact_tombstones", 1000, "in watch mode, compact a repository's shards once a shard has more than this many file tombstones (0 disables)")
 	flag.Parse()
 
 	// Create and ensure index directory exists
@@ -312,7 +327,11 @@ func main() {
 
 	if flag.NArg() >= 1 && flag.Arg(0) == "watch" {
 		configNames := flag.Args()[1:]
-		if err := watchConfigs(configNames, outputIndexDir, *watchDebounce); err != nil {
+		compaction := compactionThresholds{
+			deltaShards:    *watchCompactShards,
+			fileTombstones: *watchCompactTombstones,
+		}
+		if err := watchConfigs(configNames, outputIndexDir, *watchDebounce, compaction); err != nil {
 			log.Fatalf("watch failed: %v", err)
 		}
 
@@ -372,7 +391,11 @@ func main() {
 		if err := zoekt.SetFileSystemRoot(&opts.RepositoryDescription, absArg); err != nil {
 			log.Fatal(err)
 		}
-		if err := indexArgWithFilters(arg, *opts, ignoreDirMap, nil); err != nil {
+		gitIgnore, err := newGitIgnoreFilter([]string{absArg}, *useGitIgnore)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := indexArgWithFilters(arg, *opts, ignoreDirMap, nil, gitIgnore); err != nil {
 			log.Fatal(err)
 		}
 	}
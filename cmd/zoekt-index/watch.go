@@ -3,10 +3,11 @@ package main
 import (
 	"fmt"
 	"log"
+	"maps"
 	"os"
-	"os/signal"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/sourcegraph/zoekt"
 	"github.com/sourcegraph/zoekt/cmd"
 	"github.com/sourcegraph/zoekt/index"
+	"github.com/sourcegraph/zoekt/internal/fswatch"
 )
 
 type namedConfig struct {
@@ -28,6 +30,20 @@ type watchSpec struct {
 	roots      []string
 	ignoreDirs map[string]struct{}
 	fileExts   map[string]struct{}
+	gitIgnore  *gitIgnoreFilter
+}
+
+// ignoresPath returns true if changes to path never affect the index of the
+// spec's config.
+func (s watchSpec) ignoresPath(path string) bool {
+	if shouldIgnoreWatchPath(path, s.ignoreDirs) {
+		return true
+	}
+	if s.gitIgnore == nil {
+		return false
+	}
+	info, err := os.Stat(path)
+	return s.gitIgnore.ignored(path, err == nil && info.IsDir())
 }
 
 func buildIgnoreDirMap(raw string) map[string]struct{} {
@@ -156,47 +172,107 @@ func runNamedConfigs(configs []namedConfig, defaultIndexDir string, withProfiles
 }
 
 func runIndexConfig(configName string, config *IndexConfig, defaultIndexDir string) error {
-	ignoreDirMap := buildIgnoreDirMap(config.IgnoreDirs)
-	fileExts := buildFileExtMap(config.FileExtensions)
+	opts, err := configIndexOptions(configName, config, defaultIndexDir)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Indexing config %q as repository: %s", configName, opts.RepositoryDescription.Name)
+	if err := indexConfigPaths(config, opts, buildIgnoreDirMap(config.IgnoreDirs), buildFileExtMap(config.FileExtensions)); err != nil {
+		return err
+	}
+	if err := cleanupLegacyConfigShards(config, opts.IndexDir, opts.RepositoryDescription.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// runIndexConfigDelta reindexes only the given absolute paths of a config by
+// writing a delta shard on top of the existing shards. Paths that no longer
+// exist are tombstoned. If the delta build cannot be performed, it falls back
+// to a full rebuild of the config.
+func runIndexConfigDelta(configName string, config *IndexConfig, defaultIndexDir string, changed []string, compaction compactionThresholds) error {
+	opts, err := configIndexOptions(configName, config, defaultIndexDir)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Indexing %d changed path(s) of config %q as repository: %s", len(changed), configName, opts.RepositoryDescription.Name)
+	err = indexConfigDelta(config, opts, changed, buildIgnoreDirMap(config.IgnoreDirs), buildFileExtMap(config.FileExtensions))
+	if err != nil {
+		log.Printf("delta build: falling back to normal build since delta build failed, config=%q, err=%s", configName, err)
+		return runIndexConfig(configName, config, defaultIndexDir)
+	}
+
+	return maybeCompactShards(opts, compaction)
+}
+
+// compactionThresholds decide when the shards of a repository are compacted
+// after a delta build. A zero threshold disables the respective check.
+type compactionThresholds struct {
+	// deltaShards is the maximum number of delta shards per repository.
+	deltaShards int
+
+	// fileTombstones is the maximum number of file tombstones per shard.
+	fileTombstones int
+}
 
+func (c compactionThresholds) exceeded(stats index.DeltaStats) bool {
+	return (c.deltaShards > 0 && stats.DeltaShards > c.deltaShards) ||
+		(c.fileTombstones > 0 && stats.FileTombstones > c.fileTombstones)
+}
+
+// maybeCompactShards merges the delta shards of the repository back into a
+// fresh shard set once they exceed the compaction thresholds.
+func maybeCompactShards(opts index.Options, compaction compactionThresholds) error {
+	stats, err := opts.DeltaStats()
+	if err != nil {
+		return err
+	}
+	if !compaction.exceeded(stats) {
+		return nil
+	}
+
+	log.Printf("compacting %d shard(s) of repository %s (%d delta shard(s), %d file tombstone(s))", stats.Shards, opts.RepositoryDescription.Name, stats.DeltaShards, stats.FileTombstones)
+	if err := index.CompactShards(opts); err != nil {
+		return fmt.Errorf("compacting shards of %s: %w", opts.RepositoryDescription.Name, err)
+	}
+	return nil
+}
+
+// configIndexOptions returns the build options for indexing the given config
+// into its index directory, creating the directory if necessary.
+func configIndexOptions(configName string, config *IndexConfig, defaultIndexDir string) (index.Options, error) {
 	indexOutputDir := defaultIndexDir
 	if config.IndexDir != "" {
 		indexOutputDir = config.IndexDir
 	}
 
 	if err := os.MkdirAll(indexOutputDir, 0o755); err != nil {
-		return fmt.Errorf("creating index directory: %w", err)
+		return index.Options{}, fmt.Errorf("creating index directory: %w", err)
 	}
 
-	repoName := configRepoName(configName, config)
-	opts := cmd.OptionsFromFlags()
+	opts := *cmd.OptionsFromFlags()
 	opts.IndexDir = indexOutputDir
 	if config.Parallelism > 0 {
 		opts.Parallelism = config.Parallelism
 	}
-	opts.RepositoryDescription.Name = repoName
+	opts.RepositoryDescription.Name = configRepoName(configName, config)
 	if len(config.Paths) == 1 {
 		absPath, err := filepath.Abs(filepath.Clean(config.Paths[0]))
 		if err != nil {
-			return err
+			return index.Options{}, err
 		}
 		opts.RepositoryDescription.Source = absPath
 	} else {
 		opts.RepositoryDescription.Source = "config:" + configName
 	}
 
-	log.Printf("Indexing config %q as repository: %s", configName, repoName)
-	if err := indexConfigPaths(config, *opts, ignoreDirMap, fileExts); err != nil {
-		return err
-	}
-	if err := cleanupLegacyConfigShards(config, indexOutputDir, repoName); err != nil {
-		return err
-	}
-
-	return nil
+	return opts, nil
 }
 
-func watchConfigs(configNames []string, defaultIndexDir string, debounce time.Duration) error {
+func watchConfigs(configNames []string, defaultIndexDir string, debounce time.Duration, compaction compactionThresholds) error {
 	if debounce <= 0 {
 		return fmt.Errorf("watch_debounce must be greater than zero")
 	}
@@ -235,45 +311,23 @@ func watchConfigs(configNames []string, defaultIndexDir string, debounce time.Du
 		return err
 	}
 
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, os.Interrupt)
-	defer signal.Stop(sigCh)
-
-	changeCh := make(chan string, 1)
-	doneCh := make(chan error, 1)
-
-	queueChange := func(reason string) {
-		select {
-		case changeCh <- reason:
-		default:
-		}
-	}
-
 	var (
-		timer         *time.Timer
-		timerCh       <-chan time.Time
-		pending       bool
-		pendingReason string
-		running       bool
+		changes  = watchChanges{}
+		inflight watchChanges
 	)
 
-	startIndex := func(reason string) {
-		running = true
-		log.Printf("detected changes, reindexing (%s)", reason)
-		go func() {
-			doneCh <- runNamedConfigs(configs, defaultIndexDir, false)
-		}()
-	}
-
-	for {
-		select {
-		case event, ok := <-watcher.Events:
-			if !ok {
-				return nil
+	loop := &fswatch.Loop{
+		Watcher:  watcher,
+		Debounce: debounce,
+		Event: func(event fsnotify.Event) string {
+			if shouldIgnoreWatchPathForAllSpecs(event.Name, specs) {
+				return ""
 			}
 
-			if shouldIgnoreWatchPathForAllSpecs(event.Name, specs) {
-				continue
+			// A changed .gitignore can include or exclude any number of
+			// files, so its configs need a full rebuild.
+			if changes.gitIgnoreChanged(event.Name, specs) {
+				return "gitignore change: " + event.Name
 			}
 
 			if event.Op&fsnotify.Create != 0 {
@@ -282,74 +336,126 @@ func watchConfigs(configNames []string, defaultIndexDir string, debounce time.Du
 					if err := addWatchDirForSpecs(watcher, event.Name, specs); err != nil {
 						log.Printf("failed to add watcher for %s: %v", event.Name, err)
 					}
-					queueChange("directory create: " + event.Name)
-					continue
+					changes.addPath(event.Name, specs)
+					return "directory create: " + event.Name
 				}
 			}
 
 			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
-				_ = watcher.Remove(event.Name)
+				// Only directories are watched, so a successful removal means a
+				// whole directory went away. We don't know which files it
+				// contained, so its configs need a full rebuild.
+				if err := watcher.Remove(event.Name); err == nil {
+					changes.markFull(event.Name, specs)
+					return "directory remove: " + event.Name
+				}
 			}
 
 			if watchEventRelevantForSpecs(event, specs) {
-				queueChange(event.String())
+				changes.addPath(event.Name, specs)
+				return event.String()
 			}
-
-		case err, ok := <-watcher.Errors:
-			if !ok {
-				return nil
+			return ""
+		},
+		Start: func() func() error {
+			inflight = changes
+			changes = watchChanges{}
+			return func() error {
+				return runWatchChanges(configs, inflight, defaultIndexDir, compaction)
 			}
+		},
+		Done: func(err error) {
 			if err != nil {
-				log.Printf("watch error: %v", err)
+				// The failed changes are not retried on their own, but the
+				// next reindex of these configs must not miss them.
+				for name := range inflight {
+					changes.forConfig(name).full = true
+				}
 			}
+		},
+	}
+	return loop.Run()
+}
 
-		case reason := <-changeCh:
-			pending = true
-			if pendingReason == "" {
-				pendingReason = reason
-			}
-			if timer == nil {
-				timer = time.NewTimer(debounce)
-			} else {
-				resetTimer(timer, debounce)
-			}
-			timerCh = timer.C
-
-		case <-timerCh:
-			timerCh = nil
-			if pending && !running {
-				reason := pendingReason
-				pending = false
-				pendingReason = ""
-				startIndex(reason)
-			}
+// configChanges records what changed below the roots of a single watched
+// config since it was last indexed.
+type configChanges struct {
+	// paths holds the absolute paths of changed or removed files, and of
+	// created directories.
+	paths map[string]struct{}
 
-		case err := <-doneCh:
-			running = false
-			if err != nil {
-				log.Printf("reindex failed: %v", err)
-			} else {
-				log.Printf("reindex complete")
-			}
+	// full is set if the changes cannot be expressed as a set of paths, eg.
+	// because a directory was removed.
+	full bool
+}
 
-			if pending {
-				if timer == nil {
-					timer = time.NewTimer(debounce)
-				} else {
-					resetTimer(timer, debounce)
-				}
-				timerCh = timer.C
-			}
+// watchChanges maps config names to their pending changes.
+type watchChanges map[string]*configChanges
 
-		case <-sigCh:
-			log.Printf("stopping watch mode")
-			return nil
+func (c watchChanges) forConfig(name string) *configChanges {
+	cc, ok := c[name]
+	if !ok {
+		cc = &configChanges{paths: map[string]struct{}{}}
+		c[name] = cc
+	}
+	return cc
+}
+
+// addPath records path as changed for every spec that watches it.
+func (c watchChanges) addPath(path string, specs []watchSpec) {
+	for _, spec := range specs {
+		if !pathWithinRoots(path, spec.roots) || spec.ignoresPath(path) {
+			continue
+		}
+		c.forConfig(spec.name).paths[path] = struct{}{}
+	}
+}
+
+// markFull requests a full rebuild for every spec that watches path.
+func (c watchChanges) markFull(path string, specs []watchSpec) {
+	for _, spec := range specs {
+		if pathWithinRoots(path, spec.roots) {
+			c.forConfig(spec.name).full = true
+		}
+	}
+}
+
+// gitIgnoreChanged requests a full rebuild for every spec that applies the
+// .gitignore file at path. It returns false if no spec uses it.
+func (c watchChanges) gitIgnoreChanged(path string, specs []watchSpec) bool {
+	changed := false
+	for _, spec := range specs {
+		if pathWithinRoots(path, spec.roots) && spec.gitIgnore.invalidate(path) {
+			c.forConfig(spec.name).full = true
+			changed = true
 		}
 	}
+	return changed
 }
 
-func addWatchTree(watcher *fsnotify.Watcher, root string, ignoreDirs map[string]struct{}) error {
-	return addWatchTreeDedup(watcher, root, ignoreDirs, nil)
+// runWatchChanges reindexes the configs with pending changes. Configs which
+// only saw individual paths change get a delta shard, all others are rebuilt.
+func runWatchChanges(configs []namedConfig, changes watchChanges, defaultIndexDir string, compaction compactionThresholds) error {
+	for _, cfg := range configs {
+		cc, ok := changes[cfg.name]
+		if !ok {
+			continue
+		}
+
+		if cc.full {
+			if err := runIndexConfig(cfg.name, cfg.config, defaultIndexDir); err != nil {
+				return err
+			}
+			continue
+		}
+
+		paths := slices.Sorted(maps.Keys(cc.paths))
+		if err := runIndexConfigDelta(cfg.name, cfg.config, defaultIndexDir, paths, compaction); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func newWatchSpec(cfg namedConfig) (watchSpec, error) {
@@ -365,19 +471,24 @@ func newWatchSpec(cfg namedConfig) (watchSpec, error) {
 		return watchSpec{}, fmt.Errorf("configuration %q has no paths", cfg.name)
 	}
 
+	gitIgnore, err := newGitIgnoreFilter(roots, cfg.config.UseGitIgnore)
+	if err != nil {
+		return watchSpec{}, err
+	}
+
 	return watchSpec{
 		name:       cfg.name,
 		roots:      roots,
 		ignoreDirs: buildIgnoreDirMap(cfg.config.IgnoreDirs),
 		fileExts:   buildFileExtMap(cfg.config.FileExtensions),
+		gitIgnore:  gitIgnore,
 	}, nil
 }
 
 func addWatchSpecs(watcher *fsnotify.Watcher, specs []watchSpec) error {
-	added := map[string]struct{}{}
 	for _, spec := range specs {
 		for _, root := range spec.roots {
-			if err := addWatchTreeDedup(watcher, root, spec.ignoreDirs, added); err != nil {
+			if err := addWatchTreeFiltered(watcher, root, spec.ignoreDirs, spec.gitIgnore); err != nil {
 				return err
 			}
 		}
@@ -386,54 +497,34 @@ func addWatchSpecs(watcher *fsnotify.Watcher, specs []watchSpec) error {
 }
 
 func addWatchDirForSpecs(watcher *fsnotify.Watcher, dir string, specs []watchSpec) error {
-	added := map[string]struct{}{}
 	for _, spec := range specs {
 		if !pathWithinRoots(dir, spec.roots) {
 			continue
 		}
-		if err := addWatchTreeDedup(watcher, dir, spec.ignoreDirs, added); err != nil {
+		if err := addWatchTreeFiltered(watcher, dir, spec.ignoreDirs, spec.gitIgnore); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func addWatchTreeDedup(watcher *fsnotify.Watcher, root string, ignoreDirs map[string]struct{}, added map[string]struct{}) error {
+// addWatchTreeFiltered watches root and its subdirectories that are neither
+// in ignoreDirs nor ignored by gitIgnore. Directories watched for several
+// specs are watched once, since fsnotify ignores repeated watches.
+func addWatchTreeFiltered(watcher *fsnotify.Watcher, root string, ignoreDirs map[string]struct{}, gitIgnore *gitIgnoreFilter) error {
 	root, err := filepath.Abs(filepath.Clean(root))
 	if err != nil {
 		return err
 	}
 
-	return filepath.Walk(root, func(path string, info os.FileInfo, walkErr error) error {
-		if walkErr != nil {
-			return walkErr
-		}
-		if !info.IsDir() {
-			return nil
-		}
-		if shouldIgnoreWatchPath(path, ignoreDirs) {
-			return filepath.SkipDir
-		}
-		if added != nil {
-			if _, ok := added[path]; ok {
-				return nil
-			}
-			added[path] = struct{}{}
-		}
-		if err := watcher.Add(path); err != nil {
-			return fmt.Errorf("adding watch for %s: %w", path, err)
-		}
-		return nil
+	return fswatch.AddTree(watcher, root, func(path string) bool {
+		return shouldIgnoreWatchPath(path, ignoreDirs) || gitIgnore.ignored(path, true)
 	})
 }
 
 func pathWithinRoots(path string, roots []string) bool {
 	for _, root := range roots {
-		rel, err := filepath.Rel(root, path)
-		if err != nil {
-			continue
-		}
-		if rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))) {
+		if fswatch.Within(path, root) {
 			return true
 		}
 	}
@@ -465,7 +556,7 @@ func shouldIgnoreWatchPathForAllSpecs(path string, specs []watchSpec) bool {
 		return true
 	}
 	for _, spec := range specs {
-		if pathWithinRoots(path, spec.roots) && !shouldIgnoreWatchPath(path, spec.ignoreDirs) {
+		if pathWithinRoots(path, spec.roots) && !spec.ignoresPath(path) {
 			return false
 		}
 	}
@@ -504,7 +595,7 @@ func watchEventRelevant(event fsnotify.Event, fileExts map[string]struct{}, igno
 
 func watchEventRelevantForSpecs(event fsnotify.Event, specs []watchSpec) bool {
 	for _, spec := range specs {
-		if !pathWithinRoots(event.Name, spec.roots) {
+		if !pathWithinRoots(event.Name, spec.roots) || spec.ignoresPath(event.Name) {
 			continue
 		}
 		if watchEventRelevant(event, spec.fileExts, spec.ignoreDirs) {
@@ -514,16 +605,6 @@ func watchEventRelevantForSpecs(event fsnotify.Event, specs []watchSpec) bool {
 	return false
 }
 
-func resetTimer(timer *time.Timer, delay time.Duration) {
-	if !timer.Stop() {
-		select {
-		case <-timer.C:
-		default:
-		}
-	}
-	timer.Reset(delay)
-}
-
 func configRepoName(configName string, config *IndexConfig) string {
 	if config.RepoName != "" {
 		return config.RepoName
@@ -596,6 +677,11 @@ func indexConfigPaths(config *IndexConfig, opts index.Options, ignore map[string
 		return err
 	}
 
+	gitIgnore, err := newGitIgnoreFilter(config.Paths, config.UseGitIgnore)
+	if err != nil {
+		return err
+	}
+
 	builder, err := index.NewBuilder(opts)
 	if err != nil {
 		return err
@@ -604,7 +690,7 @@ func indexConfigPaths(config *IndexConfig, opts index.Options, ignore map[string
 
 	for i, path := range config.Paths {
 		log.Printf("adding path to repository %s: %s", opts.RepositoryDescription.Name, path)
-		if err := addPathToBuilder(builder, path, prefixes[i], opts, ignore, fileExts); err != nil {
+		if err := addPathToBuilder(builder, path, prefixes[i], opts, ignore, fileExts, gitIgnore); err != nil {
 			return fmt.Errorf("indexing %s: %w", path, err)
 		}
 	}
@@ -612,6 +698,95 @@ func indexConfigPaths(config *IndexConfig, opts index.Options, ignore map[string
 	return builder.Finish()
 }
 
+// indexConfigDelta writes a delta shard containing the current contents of
+// the given absolute paths, and tombstones their previous versions in the
+// existing shards of the repository.
+func indexConfigDelta(config *IndexConfig, opts index.Options, changed []string, ignore map[string]struct{}, fileExts map[string]struct{}) error {
+	if len(opts.FindAllShards()) == 0 {
+		return fmt.Errorf("no existing shards found for repository")
+	}
+
+	prefixes, err := configPathPrefixes(config.Paths)
+	if err != nil {
+		return err
+	}
+
+	if err := zoekt.SetFileSystemRoots(&opts.RepositoryDescription, configFileSystemRoots(config.Paths, prefixes)); err != nil {
+		return err
+	}
+
+	roots := make([]string, 0, len(config.Paths))
+	for _, path := range config.Paths {
+		abs, err := filepath.Abs(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		roots = append(roots, abs)
+	}
+
+	gitIgnore, err := newGitIgnoreFilter(roots, config.UseGitIgnore)
+	if err != nil {
+		return err
+	}
+
+	opts.IsDelta = true
+	builder, err := index.NewBuilder(opts)
+	if err != nil {
+		return err
+	}
+	defer builder.Finish() // nolint:errcheck
+
+	for _, path := range changed {
+		i := slices.IndexFunc(roots, func(root string) bool {
+			return pathWithinRoots(path, []string{root})
+		})
+		if i < 0 {
+			continue
+		}
+
+		displayName := displayNameForIndexedPath(roots[i], path)
+		if prefixes[i] != "" {
+			displayName = filepath.ToSlash(filepath.Join(prefixes[i], displayName))
+		}
+
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			builder.MarkFileAsChangedOrRemoved(displayName)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			// Files below a new directory are marked as changed by
+			// addPathToBuilder.
+			prefix := displayName
+			if path == roots[i] {
+				prefix = prefixes[i]
+			}
+			if err := addPathToBuilder(builder, path, prefix, opts, ignore, fileExts, gitIgnore); err != nil {
+				return fmt.Errorf("indexing %s: %w", path, err)
+			}
+			continue
+		}
+
+		builder.MarkFileAsChangedOrRemoved(displayName)
+		if !info.Mode().IsRegular() || gitIgnore.ignored(path, false) {
+			continue
+		}
+		if len(fileExts) > 0 {
+			if _, ok := fileExts[strings.ToLower(filepath.Ext(path))]; !ok {
+				continue
+			}
+		}
+		if err := addFileToBuilder(builder, fileInfo{path, info.Size()}, displayName, opts); err != nil {
+			return err
+		}
+	}
+
+	return builder.Finish()
+}
+
 func configPathPrefixes(paths []string) ([]string, error) {
 	if len(paths) == 0 {
 		return nil, nil
@@ -712,7 +887,7 @@ func splitPathParts(path string) []string {
 	return parts
 }
 
-func indexArg(arg string, opts index.Options, ignore map[string]struct{}, fileExts map[string]struct{}) error {
+func indexArg(arg string, opts index.Options, ignore map[string]struct{}, fileExts map[string]struct{}, gitIgnore *gitIgnoreFilter) error {
 	dir, err := filepath.Abs(filepath.Clean(arg))
 	if err != nil {
 		return err
@@ -725,14 +900,14 @@ func indexArg(arg string, opts index.Options, ignore map[string]struct{}, fileEx
 	}
 	defer builder.Finish() // nolint:errcheck
 
-	if err := addPathToBuilder(builder, dir, "", opts, ignore, fileExts); err != nil {
+	if err := addPathToBuilder(builder, dir, "", opts, ignore, fileExts, gitIgnore); err != nil {
 		return err
 	}
 
 	return builder.Finish()
 }
 
-func addPathToBuilder(builder *index.Builder, arg, pathPrefix string, opts index.Options, ignore map[string]struct{}, fileExts map[string]struct{}) error {
+func addPathToBuilder(builder *index.Builder, arg, pathPrefix string, opts index.Options, ignore map[string]struct{}, fileExts map[string]struct{}, gitIgnore *gitIgnoreFilter) error {
 	dir, err := filepath.Abs(filepath.Clean(arg))
 	if err != nil {
 		return err
@@ -744,6 +919,7 @@ func addPathToBuilder(builder *index.Builder, arg, pathPrefix string, opts index
 		sink:       comm,
 		sizeMax:    int64(opts.SizeMax),
 		fileExts:   fileExts,
+		gitIgnore:  gitIgnore,
 	}
 
 	go func() {
@@ -758,21 +934,12 @@ func addPathToBuilder(builder *index.Builder, arg, pathPrefix string, opts index
 		if pathPrefix != "" {
 			displayName = filepath.ToSlash(filepath.Join(pathPrefix, displayName))
 		}
-		if f.size > int64(opts.SizeMax) && !opts.IgnoreSizeMax(displayName) {
-			if err := builder.Add(index.Document{
-				Name:       displayName,
-				SkipReason: index.SkipReasonTooLarge,
-			}); err != nil {
-				return err
-			}
-			continue
-		}
-		content, err := os.ReadFile(f.name)
-		if err != nil {
-			return err
+		if opts.IsDelta {
+			// In a delta build, the added file replaces any version of it
+			// in the older shards.
+			builder.MarkFileAsChangedOrRemoved(displayName)
 		}
-
-		if err := builder.AddFile(displayName, content); err != nil {
+		if err := addFileToBuilder(builder, f, displayName, opts); err != nil {
 			return err
 		}
 	}
@@ -780,11 +947,26 @@ func addPathToBuilder(builder *index.Builder, arg, pathPrefix string, opts index
 	return nil
 }
 
-func indexArgWithFilters(arg string, opts index.Options, ignore map[string]struct{}, fileExts map[string]struct{}) error {
+func addFileToBuilder(builder *index.Builder, f fileInfo, displayName string, opts index.Options) error {
+	if f.size > int64(opts.SizeMax) && !opts.IgnoreSizeMax(displayName) {
+		return builder.Add(index.Document{
+			Name:       displayName,
+			SkipReason: index.SkipReasonTooLarge,
+		})
+	}
+	content, err := os.ReadFile(f.name)
+	if err != nil {
+		return err
+	}
+
+	return builder.AddFile(displayName, content)
+}
+
+func indexArgWithFilters(arg string, opts index.Options, ignore map[string]struct{}, fileExts map[string]struct{}, gitIgnore *gitIgnoreFilter) error {
 	if opts.RepositoryDescription.Name == "" {
 		opts.RepositoryDescription.Name = filepath.Base(filepath.Clean(arg))
 	}
-	return indexArg(arg, opts, ignore, fileExts)
+	return indexArg(arg, opts, ignore, fileExts, gitIgnore)
 }
 
 func nukeIndexDir(indexDir string) error {
@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
 	"testing"
 
 	"github.com/fsnotify/fsnotify"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/ignore"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/search"
 )
 
 func TestDisplayNameForIndexedPath(t *testing.T) {
@@ -260,3 +267,200 @@ func TestLoadNamedConfigsFromDir(t *testing.T) {
 		}
 	})
 }
+
+func TestWatchChanges(t *testing.T) {
+	root := t.TempDir()
+	specs := []watchSpec{{
+		name:       "repo",
+		roots:      []string{root},
+		ignoreDirs: buildIgnoreDirMap(".git"),
+	}}
+
+	changes := watchChanges{}
+	changes.addPath(filepath.Join(root, "main.go"), specs)
+	changes.addPath(filepath.Join(root, ".git", "index"), specs)
+	changes.addPath(filepath.Join(t.TempDir(), "other.go"), specs)
+
+	cc, ok := changes["repo"]
+	if !ok {
+		t.Fatalf("expected changes for config %q", "repo")
+	}
+	if got, want := slices.Sorted(maps.Keys(cc.paths)), []string{filepath.Join(root, "main.go")}; !slices.Equal(got, want) {
+		t.Fatalf("paths = %#v, want %#v", got, want)
+	}
+	if cc.full {
+		t.Fatalf("full rebuild requested unexpectedly")
+	}
+
+	changes.markFull(filepath.Join(root, "src"), specs)
+	if !changes["repo"].full {
+		t.Fatalf("markFull did not request a full rebuild")
+	}
+}
+
+func TestIndexConfigDelta(t *testing.T) {
+	src := t.TempDir()
+	indexDir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(src, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeFile("changed.go", "alpha")
+	writeFile("removed.go", "beta")
+	writeFile("kept.go", "epsilon")
+
+	config := &IndexConfig{Paths: []string{src}, IgnoreDirs: ".git"}
+	opts, err := configIndexOptions("repo", config, indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.DisableCTags = true
+	ignore := buildIgnoreDirMap(config.IgnoreDirs)
+
+	if err := indexConfigPaths(config, opts, ignore, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile("changed.go", "gamma")
+	if err := os.Remove(filepath.Join(src, "removed.go")); err != nil {
+		t.Fatal(err)
+	}
+	writeFile("added/new.go", "delta")
+
+	changed := []string{
+		filepath.Join(src, "changed.go"),
+		filepath.Join(src, "removed.go"),
+		filepath.Join(src, "added"),
+	}
+	if err := indexConfigDelta(config, opts, changed, ignore, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(opts.FindAllShards()); got != 2 {
+		t.Fatalf("got %d shards, want 2", got)
+	}
+
+	ss, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+
+	cases := []struct {
+		pattern string
+		want    []string
+	}{
+		{pattern: "alpha"},
+		{pattern: "beta"},
+		{pattern: "gamma", want: []string{"changed.go"}},
+		{pattern: "delta", want: []string{"added/new.go"}},
+		{pattern: "epsilon", want: []string{"kept.go"}},
+	}
+
+	for _, tc := range cases {
+		res, err := ss.Search(context.Background(), &query.Substring{Pattern: tc.pattern, Content: true}, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, f := range res.Files {
+			got = append(got, f.FileName)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("search %q: got files %#v, want %#v", tc.pattern, got, tc.want)
+		}
+	}
+}
+
+func TestIndexConfigGitIgnore(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	src := t.TempDir()
+	indexDir := t.TempDir()
+	for name, content := range map[string]string{
+		".git/info/exclude":         "*.local\n",
+		".gitignore":                "node_modules/\n*.log\n",
+		"main.go":                   "package main",
+		"debug.log":                 "package main",
+		"settings.local":            "package main",
+		"node_modules/pkg/index.js": "package main",
+		"gen/.gitignore":            "*.pb.go\n",
+		"gen/api.pb.go":             "package main",
+		"gen/api.go":                "package main",
+	} {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := &IndexConfig{Paths: []string{src}, IgnoreDirs: ".git", UseGitIgnore: true}
+	opts, err := configIndexOptions("repo", config, indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.DisableCTags = true
+
+	if err := indexConfigPaths(config, opts, buildIgnoreDirMap(config.IgnoreDirs), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	ss, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+
+	res, err := ss.Search(context.Background(), &query.Substring{Pattern: "package main", Content: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, f := range res.Files {
+		got = append(got, f.FileName)
+	}
+	slices.Sort(got)
+	if want := []string{"gen/api.go", "main.go"}; !slices.Equal(got, want) {
+		t.Fatalf("got files %#v, want %#v", got, want)
+	}
+
+	spec, err := newWatchSpec(namedConfig{name: "repo", config: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]bool{
+		filepath.Join(src, "main.go"):                   false,
+		filepath.Join(src, "gen", "api.pb.go"):          true,
+		filepath.Join(src, "node_modules", "pkg", "x"):  true,
+		filepath.Join(src, "node_modules"):              true,
+		filepath.Join(src, "gen", ignore.GitIgnoreFile): false,
+	} {
+		if got := spec.ignoresPath(path); got != want {
+			t.Errorf("ignoresPath(%q) = %t, want %t", path, got, want)
+		}
+	}
+
+	changes := watchChanges{}
+	if !changes.gitIgnoreChanged(filepath.Join(src, "gen", ignore.GitIgnoreFile), []watchSpec{spec}) {
+		t.Fatalf("gitIgnoreChanged() = false, want true")
+	}
+	if !changes["repo"].full {
+		t.Fatalf("a changed .gitignore did not request a full rebuild")
+	}
+}
@@ -10,7 +10,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Command zoekt-merge-index merges a set of index shards into a compound shard.
+// Command zoekt-merge-index merges a set of index shards into compound shards.
 package main
 
 import (
@@ -24,54 +24,69 @@ import (
 	"github.com/sourcegraph/zoekt/index"
 )
 
-// merge merges the input shards into a compound shard in dstDir. It returns the
-// full path to the compound shard. The input shards are removed on success.
-func merge(dstDir string, names []string) (string, error) {
+// merge merges the input shards into compound shards in dstDir. It returns the
+// full paths to the compound shards. The input shards are removed on success.
+//
+// Usually all shards are merged into one compound shard. If the sections of
+// the compound shard would exceed their size limit, the shards are split
+// into several compound shards.
+func merge(dstDir string, names []string) ([]string, error) {
 	var files []index.IndexFile
+	byFile := map[index.IndexFile]string{}
 	for _, fn := range names {
 		f, err := os.Open(fn)
 		if err != nil {
-			return "", nil
+			return nil, nil
 		}
 		defer f.Close()
 
 		indexFile, err := index.NewIndexFile(f)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		defer indexFile.Close()
 
 		files = append(files, indexFile)
+		byFile[indexFile] = fn
 	}
 
-	tmpName, dstName, err := index.Merge(dstDir, files...)
+	groups, err := index.MergeGroups(files)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Delete input shards.
-	for _, name := range names {
-		paths, err := index.IndexFilePaths(name)
+	var dstNames []string
+	for _, group := range groups {
+		tmpName, dstName, err := index.Merge(dstDir, group...)
 		if err != nil {
-			return "", fmt.Errorf("zoekt-merge-index: %w", err)
+			return nil, err
 		}
-		for _, p := range paths {
-			if err := os.Remove(p); err != nil {
-				return "", fmt.Errorf("zoekt-merge-index: failed to remove simple shard: %w", err)
+
+		// Delete input shards.
+		for _, f := range group {
+			paths, err := index.IndexFilePaths(byFile[f])
+			if err != nil {
+				return nil, fmt.Errorf("zoekt-merge-index: %w", err)
+			}
+			for _, p := range paths {
+				if err := os.Remove(p); err != nil {
+					return nil, fmt.Errorf("zoekt-merge-index: failed to remove simple shard: %w", err)
+				}
 			}
 		}
-	}
 
-	// We only rename the compound shard if all simple shards could be deleted in the
-	// previous step. This guarantees we won't have duplicate indexes.
-	if err := os.Rename(tmpName, dstName); err != nil {
-		return "", fmt.Errorf("zoekt-merge-index: failed to rename compound shard: %w", err)
+		// We only rename the compound shard if all simple shards could be deleted in the
+		// previous step. This guarantees we won't have duplicate indexes.
+		if err := os.Rename(tmpName, dstName); err != nil {
+			return nil, fmt.Errorf("zoekt-merge-index: failed to rename compound shard: %w", err)
+		}
+		dstNames = append(dstNames, dstName)
 	}
 
-	return dstName, nil
+	return dstNames, nil
 }
 
-func mergeCmd(paths []string) (string, error) {
+func mergeCmd(paths []string) ([]string, error) {
 	if paths[0] == "-" {
 		paths = []string{}
 		scanner := bufio.NewScanner(os.Stdin)
@@ -79,7 +94,7 @@ func mergeCmd(paths []string) (string, error) {
 			paths = append(paths, strings.TrimSpace(scanner.Text()))
 		}
 		if err := scanner.Err(); err != nil {
-			return "", err
+			return nil, err
 		}
 		log.Printf("merging %d paths from stdin", len(paths))
 	}
@@ -94,11 +109,13 @@ func explodeCmd(path string) error {
 func main() {
 	switch subCommand := os.Args[1]; subCommand {
 	case "merge":
-		compoundShardPath, err := mergeCmd(os.Args[2:])
+		compoundShardPaths, err := mergeCmd(os.Args[2:])
 		if err != nil {
 			log.Fatal(err)
 		}
-		fmt.Println(compoundShardPath)
+		for _, p := range compoundShardPaths {
+			fmt.Println(p)
+		}
 	case "explode":
 		if err := explodeCmd(os.Args[2]); err != nil {
 			log.Fatal(err)
@@ -28,9 +28,10 @@ func TestMerge(t *testing.T) {
 	dir := t.TempDir()
 	cs, err := merge(dir, testShards)
 	require.NoError(t, err)
+	require.Len(t, cs, 1)
 	// The name of the compound shard is based on the merged repos, so it should be
 	// stable
-	require.Equal(t, filepath.Base(cs), "compound-ea9613e2ffba7d7361856aebfca75fb714856509_v17.00000.zoekt")
+	require.Equal(t, filepath.Base(cs[0]), "compound-ea9613e2ffba7d7361856aebfca75fb714856509_v17.00000.zoekt")
 
 	ss, err := search.NewDirectorySearcher(dir)
 	require.NoError(t, err)
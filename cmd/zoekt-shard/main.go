@@ -0,0 +1,132 @@
+// Command zoekt-shard prints the internals of shards: the sections of the
+// TOC, the repository metadata, the documents, the largest posting lists and
+// the symbols by kind.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sourcegraph/zoekt/index"
+)
+
+func inspectShard(fn string, opts index.InspectOptions) (*index.ShardInfo, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	iFile, err := index.NewIndexFile(f)
+	if err != nil {
+		return nil, err
+	}
+	defer iFile.Close()
+
+	return index.InspectShard(iFile, opts)
+}
+
+func printShard(w io.Writer, fn string, info *index.ShardInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "shard %s: %d bytes, format version %d, feature version %d\n",
+		fn, info.Size, info.Metadata.IndexFormatVersion, info.Metadata.IndexFeatureVersion)
+	fmt.Fprintf(tw, "indexed at %s", info.Metadata.IndexTime.UTC().Format("2006-01-02 15:04:05"))
+	if v := info.Metadata.ZoektVersion; v != "" {
+		fmt.Fprintf(tw, " by zoekt %s", v)
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintf(tw, "\nREPOSITORY\tID\tBRANCHES\tTOMBSTONE\n")
+	for _, r := range info.Repositories {
+		var branches []string
+		for _, b := range r.Branches {
+			branches = append(branches, b.Name+"@"+b.Version)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%t\n", r.Name, r.ID, strings.Join(branches, ","), r.Tombstone)
+		for _, ft := range slices.Sorted(maps.Keys(r.FileTombstones)) {
+			fmt.Fprintf(tw, "  file tombstone\t%s\t\t\n", ft)
+		}
+	}
+
+	fmt.Fprintf(tw, "\nSECTION\tKIND\tOFFSET\tSIZE\tITEMS\n")
+	for _, s := range info.Sections {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\n", s.Name, s.Kind, s.Offset, s.Size, s.Items)
+	}
+
+	if len(info.Documents) > 0 {
+		fmt.Fprintf(tw, "\nDOCUMENT\tREPOSITORY\tSIZE\tLANGUAGE\tCATEGORY\tBRANCHES\tSYMBOLS\tTOMBSTONE\n")
+		for _, d := range info.Documents {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\t%d\t%t\n", d.Name, d.Repository, d.Size,
+				d.Language, d.Category, strings.Join(d.Branches, ","), d.Symbols, d.Tombstoned)
+		}
+	}
+
+	fmt.Fprintf(tw, "\n%d content ngrams, %d file name ngrams\n", info.ContentNgrams, info.FileNameNgrams)
+	fmt.Fprintf(tw, "\nLARGEST POSTING LISTS\tBYTES\tOCCURRENCES\n")
+	for _, ng := range info.LargestPostingLists {
+		fmt.Fprintf(tw, "%q\t%d\t%d\n", ng.Ngram, ng.Bytes, ng.Occurrences)
+	}
+	fmt.Fprintf(tw, "\nMOST COMMON NGRAMS\tBYTES\tOCCURRENCES\n")
+	for _, ng := range info.CommonNgrams {
+		fmt.Fprintf(tw, "%q\t%d\t%d\n", ng.Ngram, ng.Bytes, ng.Occurrences)
+	}
+
+	fmt.Fprintf(tw, "\nSYMBOL KIND\tCOUNT\n")
+	for _, kind := range slices.Sorted(maps.Keys(info.Symbols)) {
+		name := kind
+		if name == "" {
+			name = "(none)"
+		}
+		fmt.Fprintf(tw, "%s\t%d\n", name, info.Symbols[kind])
+	}
+
+	return tw.Flush()
+}
+
+func main() {
+	jsonOut := flag.Bool("json", false, "print a JSON object per shard")
+	docs := flag.Bool("docs", true, "list the documents of the shard")
+	ngrams := flag.Int("ngrams", 20, "list the top `N` ngrams by posting list size and by occurrences")
+
+	flag.Usage = func() {
+		name := os.Args[0]
+		fmt.Fprintf(os.Stderr, "Usage:\n\n  %s [option] SHARD...\n\n", name)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := index.InspectOptions{Documents: *docs, TopNgrams: *ngrams}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	for i, fn := range flag.Args() {
+		info, err := inspectShard(fn, opts)
+		if err != nil {
+			log.Fatalf("%s: %v", fn, err)
+		}
+
+		if *jsonOut {
+			err = enc.Encode(info)
+		} else {
+			if i > 0 {
+				fmt.Println()
+			}
+			err = printShard(os.Stdout, fn, info)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
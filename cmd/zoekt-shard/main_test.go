@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/zoekt/index"
+)
+
+func TestPrintShard(t *testing.T) {
+	fn := "../../testdata/shards/ctagsrepo_v16.00000.zoekt"
+	info, err := inspectShard(fn, index.InspectOptions{Documents: true, TopNgrams: 3})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, printShard(&buf, fn, info))
+	out := buf.String()
+	for _, want := range []string{
+		"shard " + fn + ": 2574 bytes",
+		"fileContents       compound",
+		"main.go   repo        112   go",
+		"95 content ngrams, 5 file name ngrams",
+		"var          2",
+	} {
+		require.Contains(t, out, want)
+	}
+
+	// The JSON output round trips.
+	data, err := json.Marshal(info)
+	require.NoError(t, err)
+	var got index.ShardInfo
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, info.Documents, got.Documents)
+	require.Equal(t, info.Sections, got.Sections)
+	require.Len(t, got.LargestPostingLists, 3)
+}
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/grafana/regexp"
@@ -99,7 +100,8 @@ func (s *Server) merge(mergeCmd func(args ...string) *exec.Cmd) {
 
 			cmd := mergeCmd(paths...)
 
-			// zoekt-merge-index writes the full path of the new compound shard to stdout.
+			// zoekt-merge-index writes the full paths of the new compound shards to
+			// stdout, one per line.
 			stdoutBuf := &bytes.Buffer{}
 			stderrBuf := &bytes.Buffer{}
 			cmd.Stdout = stdoutBuf
@@ -114,7 +116,7 @@ func (s *Server) merge(mergeCmd func(args ...string) *exec.Cmd) {
 				return
 			}
 
-			infoLog.Printf("finished merging: shard=%s durationSeconds=%.2f", stdoutBuf.String(), durationSeconds)
+			infoLog.Printf("finished merging: shards=%s durationSeconds=%.2f", strings.Fields(stdoutBuf.String()), durationSeconds)
 
 			next = true
 		})
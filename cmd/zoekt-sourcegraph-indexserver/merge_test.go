@@ -137,7 +137,7 @@ func TestMerge(t *testing.T) {
 	}{
 		{
 			name:            "3 shards",
-			targetSizeBytes: 6 * 1024,
+			targetSizeBytes: 7 * 1024,
 			wantCompound:    1,
 			wantSimple:      0,
 		},
@@ -158,7 +158,7 @@ func TestMerge(t *testing.T) {
 		},
 		{
 			name:            "target size too big",
-			targetSizeBytes: 10 * 1024,
+			targetSizeBytes: 12 * 1024,
 			wantCompound:    0,
 			wantSimple:      3,
 		},
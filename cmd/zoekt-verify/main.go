@@ -0,0 +1,97 @@
+// Command zoekt-verify checks the shards of an index directory for
+// corruption, and optionally moves corrupt shards to the quarantine
+// directory, where the webserver doesn't load them from.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/sourcegraph/zoekt/index"
+)
+
+func verifyShard(fn string) error {
+	f, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+
+	iFile, err := index.NewIndexFile(f)
+	if err != nil {
+		return err
+	}
+	defer iFile.Close()
+
+	return index.VerifyIndexFile(iFile)
+}
+
+// verify checks the shards in paths and prints the corrupt ones to w. If
+// quarantine is set, corrupt shards are moved to the quarantine directory.
+// It returns the number of corrupt shards.
+func verify(w io.Writer, paths []string, quarantine, verbose bool) (int, error) {
+	corrupt := 0
+	for _, fn := range paths {
+		err := verifyShard(fn)
+		if err == nil {
+			if verbose {
+				fmt.Fprintf(w, "ok\t%s\n", fn)
+			}
+			continue
+		}
+		if !errors.Is(err, index.ErrCorrupt) {
+			// For example, the shard was written by a newer version.
+			fmt.Fprintf(w, "skipped\t%s\t%v\n", fn, err)
+			continue
+		}
+
+		corrupt++
+		fmt.Fprintf(w, "corrupt\t%s\t%v\n", fn, err)
+		if quarantine {
+			dst, err := index.Quarantine(fn)
+			if err != nil {
+				return corrupt, fmt.Errorf("quarantine %s: %w", fn, err)
+			}
+			fmt.Fprintf(w, "quarantined\t%s\n", dst)
+		}
+	}
+	return corrupt, nil
+}
+
+func main() {
+	indexDir := flag.String("index_dir",
+		filepath.Join(os.Getenv("HOME"), ".zoekt"), "verify the index files in `directory`")
+	quarantine := flag.Bool("quarantine", false, "move corrupt shards to the "+index.QuarantineDir+" directory of their index directory")
+	verbose := flag.Bool("v", false, "also print the shards that are ok")
+
+	flag.Usage = func() {
+		name := os.Args[0]
+		fmt.Fprintf(os.Stderr, "Usage:\n\n  %s [option] [SHARD...]\n\n"+
+			"Verifies the given shards, or all shards in the index directory,\n"+
+			"and prints the corrupt ones. Exits with status 1 if a shard is corrupt.\n\n", name)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		var err error
+		paths, err = filepath.Glob(filepath.Join(*indexDir, "*.zoekt"))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	corrupt, err := verify(os.Stdout, paths, *quarantine, *verbose)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if corrupt > 0 {
+		log.Printf("found %d corrupt shard(s) out of %d", corrupt, len(paths))
+		os.Exit(1)
+	}
+}
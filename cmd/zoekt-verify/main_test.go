@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+)
+
+func writeShard(t *testing.T, fn string, corrupt bool) {
+	t.Helper()
+	b, err := index.NewShardBuilder(&zoekt.Repository{Name: "repo"})
+	require.NoError(t, err)
+	require.NoError(t, b.Add(index.Document{Name: "f", Content: []byte("a needle in a haystack\n")}))
+
+	var buf bytes.Buffer
+	require.NoError(t, b.Write(&buf))
+	data := buf.Bytes()
+	if corrupt {
+		i := bytes.Index(data, []byte("needle"))
+		require.GreaterOrEqual(t, i, 0)
+		copy(data[i:], "noodle")
+	}
+	require.NoError(t, os.WriteFile(fn, data, 0o644))
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good_v16.00000.zoekt")
+	bad := filepath.Join(dir, "bad_v16.00000.zoekt")
+	writeShard(t, good, false)
+	writeShard(t, bad, true)
+	require.NoError(t, os.WriteFile(bad+".meta", []byte("{}"), 0o644))
+
+	var out bytes.Buffer
+	corrupt, err := verify(&out, []string{good, bad}, false, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, corrupt)
+	require.Contains(t, out.String(), "ok\t"+good)
+	require.Contains(t, out.String(), "corrupt\t"+bad)
+	require.FileExists(t, bad)
+
+	out.Reset()
+	corrupt, err = verify(&out, []string{good, bad}, true, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, corrupt)
+	require.False(t, strings.Contains(out.String(), good), "got %q, want only the corrupt shard", out.String())
+
+	require.NoFileExists(t, bad)
+	require.FileExists(t, filepath.Join(dir, index.QuarantineDir, filepath.Base(bad)))
+	require.FileExists(t, filepath.Join(dir, index.QuarantineDir, filepath.Base(bad)+".meta"))
+	require.FileExists(t, good)
+}
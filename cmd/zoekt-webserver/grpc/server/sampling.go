@@ -26,9 +26,11 @@ func (s *samplingSender) Send(event *zoekt.SearchResult) {
 		s.aggCount++
 
 		s.agg.Stats.Add(event.Stats)
+		s.agg.AddFacets(event.Facets)
+		s.agg.AddCaptures(event.Captures)
 		s.agg.Progress = event.Progress
 
-		if s.aggCount%100 == 0 && !s.agg.Stats.Zero() {
+		if s.aggCount%100 == 0 && s.hasAggregate() {
 			s.next.Send(&s.agg)
 			s.agg = zoekt.SearchResult{}
 		}
@@ -39,19 +41,29 @@ func (s *samplingSender) Send(event *zoekt.SearchResult) {
 	// If we have aggregate stats, we merge them with the new event before sending
 	// it. We drop agg.Progress, because we assume that event.Progress reflects the
 	// latest status.
-	if !s.agg.Stats.Zero() {
+	if s.hasAggregate() {
 		event.Stats.Add(s.agg.Stats)
+		event.AddFacets(s.agg.Facets)
+		event.AddCaptures(s.agg.Captures)
 		s.agg = zoekt.SearchResult{}
 	}
 
 	s.next.Send(event)
 }
 
+// hasAggregate returns true if we aggregated stats, facets or captures that
+// we haven't sent yet.
+func (s *samplingSender) hasAggregate() bool {
+	return !s.agg.Stats.Zero() || s.agg.Facets != nil || s.agg.Captures != nil
+}
+
 // Flush sends any aggregated stats that we haven't sent yet
 func (s *samplingSender) Flush() {
-	if !s.agg.Stats.Zero() {
+	if s.hasAggregate() {
 		s.next.Send(&zoekt.SearchResult{
-			Stats: s.agg.Stats,
+			Stats:    s.agg.Stats,
+			Facets:   s.agg.Facets,
+			Captures: s.agg.Captures,
 			Progress: zoekt.Progress{
 				Priority:           math.Inf(-1),
 				MaxPendingPriority: math.Inf(-1),
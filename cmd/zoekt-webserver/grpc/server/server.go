@@ -70,6 +70,33 @@ func (s *Server) List(ctx context.Context, req *webserverv1.ListRequest) (*webse
 	return repoList.ToProto(), nil
 }
 
+func (s *Server) References(ctx context.Context, req *webserverv1.ReferencesRequest) (*webserverv1.SearchResponse, error) {
+	if req.GetSymbol() == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol must be set")
+	}
+
+	res, err := s.streamer.References(ctx, req.GetSymbol(), zoekt.SearchOptionsFromProto(req.GetOpts()))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ToProto(), nil
+}
+
+func (s *Server) Explain(ctx context.Context, req *webserverv1.ExplainRequest) (*webserverv1.ExplainResponse, error) {
+	q, err := query.QFromProto(req.GetQuery())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ex, err := s.streamer.Explain(ctx, q, zoekt.SearchOptionsFromProto(req.GetOpts()))
+	if err != nil {
+		return nil, err
+	}
+
+	return ex.ToProto(), nil
+}
+
 // gRPCChunkSender is a zoekt.Sender that sends small chunks of FileMatches to the provided gRPC stream.
 func gRPCChunkSender(ss webserverv1.WebserverService_StreamSearchServer) zoekt.Sender {
 	f := func(r *zoekt.SearchResult) {
@@ -90,10 +117,16 @@ func gRPCChunkSender(ss webserverv1.WebserverService_StreamSearchServer) zoekt.S
 		sendFunc := func(filesChunk []*webserverv1.FileMatch) error {
 			numFilesSent += len(filesChunk)
 
-			var stats *webserverv1.Stats
-			if !statsSent { // We only send stats back on the first chunk
+			var (
+				stats    *webserverv1.Stats
+				facets   *webserverv1.Facets
+				captures map[string]int64
+			)
+			if !statsSent { // We only send stats, facets and captures back on the first chunk
 				statsSent = true
 				stats = result.GetStats()
+				facets = result.GetFacets()
+				captures = result.GetCaptures()
 			}
 
 			progress := result.GetProgress()
@@ -116,7 +149,10 @@ func gRPCChunkSender(ss webserverv1.WebserverService_StreamSearchServer) zoekt.S
 					Files: filesChunk,
 
 					Stats:    stats,
+					Facets:   facets,
+					Captures: captures,
 					Progress: progress,
+					Cursor:   result.GetCursor(),
 				},
 			})
 		}
@@ -107,6 +107,58 @@ func TestClientServer(t *testing.T) {
 	}
 }
 
+func TestReferences(t *testing.T) {
+	const symbol = "scip-go gomod example v1 `example`/Foo()."
+	mock := &mockSearcher.MockSearcher{
+		WantSearch: &query.Occurrence{Symbol: symbol},
+		SearchResult: &zoekt.SearchResult{
+			Files: []zoekt.FileMatch{{FileName: "main.go"}},
+		},
+	}
+	srv := NewServer(adapter{mock})
+
+	r, err := srv.References(context.Background(), &webserverv1.ReferencesRequest{Symbol: symbol})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(r, mock.SearchResult.ToProto()) {
+		t.Fatalf("got %+v, want %+v", r, mock.SearchResult.ToProto())
+	}
+
+	if _, err := srv.References(context.Background(), &webserverv1.ReferencesRequest{}); err == nil {
+		t.Fatal("got no error for empty symbol")
+	}
+}
+
+func TestExplain(t *testing.T) {
+	q := &query.Substring{Pattern: "needle"}
+	mock := &mockSearcher.MockSearcher{
+		WantSearch: q,
+		Explanation: &zoekt.Explanation{
+			Query: q.String(),
+			Shards: []zoekt.ShardExplanation{{
+				Shard:     "repo_v16.00000.zoekt",
+				MatchTree: `substr("needle")`,
+				Ngrams: []zoekt.NgramExplanation{{
+					Pattern:          "needle",
+					Ngrams:           []string{"nee", "dle"},
+					PostingListSizes: []uint32{3, 4},
+				}},
+			}},
+			SkippedShards: []string{"other_v16.00000.zoekt"},
+		},
+	}
+	srv := NewServer(adapter{mock})
+
+	r, err := srv.Explain(context.Background(), &webserverv1.ExplainRequest{Query: query.QToProto(q)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(mock.Explanation.ToProto(), r, protocmp.Transform()); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestFuzzGRPCChunkSender(t *testing.T) {
 	validateResult := func(input zoekt.SearchResult) error {
 		clientStream, serverStream := newPairedSearchStream(t)
@@ -148,6 +200,8 @@ func TestFuzzGRPCChunkSender(t *testing.T) {
 				protocmp.IgnoreFields(&webserverv1.SearchResponse{},
 					"progress", // progress is tested above
 					"stats",    // aggregated stats are tested below
+					"facets",   // aggregated facets are tested below
+					"captures", // aggregated captures are tested below
 					"files",    // files are tested separately
 				),
 			}
@@ -158,10 +212,13 @@ func TestFuzzGRPCChunkSender(t *testing.T) {
 		}
 
 		receivedStats := &zoekt.Stats{}
+		var receivedAggregates zoekt.SearchResult
 
 		var receivedFileMatches []*webserverv1.FileMatch
 		for _, r := range allResponses {
 			receivedStats.Add(zoekt.StatsFromProto(r.GetStats()))
+			receivedAggregates.AddFacets(zoekt.FacetsFromProto(r.GetFacets()))
+			receivedAggregates.AddCaptures(zoekt.SearchResultFromProto(r, nil, nil).Captures)
 			receivedFileMatches = append(receivedFileMatches, r.GetFiles()...)
 		}
 
@@ -175,6 +232,16 @@ func TestFuzzGRPCChunkSender(t *testing.T) {
 			return fmt.Errorf("unexpected difference in stats (-want +got):\n%s", diff)
 		}
 
+		// Check to make sure that we get one set of facets back
+		if diff := cmp.Diff(expectedResult.GetFacets(), receivedAggregates.Facets.ToProto(), protocmp.Transform()); diff != "" {
+			return fmt.Errorf("unexpected difference in facets (-want +got):\n%s", diff)
+		}
+
+		// Check to make sure that we get one set of captures back
+		if diff := cmp.Diff(expectedResult.GetCaptures(), receivedAggregates.ToProto().GetCaptures(), cmpopts.EquateEmpty()); diff != "" {
+			return fmt.Errorf("unexpected difference in captures (-want +got):\n%s", diff)
+		}
+
 		// Check to make sure that we get the same set of file matches back
 		if diff := cmp.Diff(expectedResult.GetFiles(), receivedFileMatches,
 			protocmp.Transform(), cmpopts.EquateEmpty()); diff != "" {
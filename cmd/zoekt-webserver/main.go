@@ -137,7 +137,7 @@ func main() {
 		log.Printf("Warning: Could not determine user data directory: %v", err)
 	}
 	defaultIndexPath := filepath.Join(configDir, "indexdb")
-	indexDir := flag.String("index", defaultIndexPath, "set index directory to use")
+	indexDir := flag.String("index", defaultIndexPath, "set index directory to use, or a URI such as https://host/dir/ to read shards over HTTP")
 
 	html := flag.Bool("html", true, "enable HTML interface")
 	enableRPC := flag.Bool("rpc", false, "enable go/net RPC")
@@ -196,20 +196,27 @@ func main() {
 	// Tune GOMAXPROCS to match Linux container CPU quota.
 	_, _ = maxprocs.Set()
 
-	if err := os.MkdirAll(*indexDir, 0o755); err != nil {
-		log.Fatal(err)
-	}
+	// The index may also be a URI of a remote index file backend, such as
+	// http://, which has no disk to set up or monitor.
+	localIndexDir, isLocal := index.LocalPath(*indexDir)
+	if isLocal {
+		if err := os.MkdirAll(localIndexDir, 0o755); err != nil {
+			log.Fatal(err)
+		}
 
-	mustRegisterDiskMonitor(*indexDir)
+		mustRegisterDiskMonitor(localIndexDir)
+	}
 
 	metricsLogger := sglog.Scoped("metricsRegistration")
 
 	mustRegisterMemoryMapMetrics(metricsLogger)
 
-	opts := mountinfo.CollectorOpts{Namespace: "zoekt_webserver"}
-	c := mountinfo.NewCollector(metricsLogger, opts, map[string]string{"indexDir": *indexDir})
+	if isLocal {
+		opts := mountinfo.CollectorOpts{Namespace: "zoekt_webserver"}
+		c := mountinfo.NewCollector(metricsLogger, opts, map[string]string{"indexDir": localIndexDir})
 
-	prometheus.DefaultRegisterer.MustRegister(c)
+		prometheus.DefaultRegisterer.MustRegister(c)
+	}
 
 	// Do not block on loading shards so we can become partially available
 	// sooner. Otherwise on large instances zoekt can be unavailable on the
@@ -262,8 +269,8 @@ func main() {
 
 	debugserver.AddHandlers(serveMux, *enablePprof)
 
-	if *enableIndexserverProxy {
-		socket := filepath.Join(*indexDir, "indexserver.sock")
+	if *enableIndexserverProxy && isLocal {
+		socket := filepath.Join(localIndexDir, "indexserver.sock")
 		sglog.Scoped("server").Info("adding reverse proxy", sglog.String("socket", socket))
 		addProxyHandler(serveMux, socket)
 	}
@@ -549,6 +556,11 @@ func (s *loggedSearcher) StreamSearch(
 	return err
 }
 
+func (s *loggedSearcher) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	// Go through Search so that the request is counted and logged.
+	return s.Search(ctx, &query.Occurrence{Symbol: symbol}, opts)
+}
+
 func (s *loggedSearcher) log(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, st *zoekt.Stats, err error) {
 	logger := s.Logger.
 		WithTrace(traceContext(ctx)).
@@ -193,7 +193,7 @@ func (h *mcpHandler) handleMessage(ctx context.Context, version string, raw json
 		if !req.hasID() {
 			return mcpResponse{}, false
 		}
-		result, err := h.handleToolCall(ctx, req.Params)
+		result, err := h.handleToolCall(ctx, version, req.Params)
 		if err != nil {
 			return newMCPErrorResponse(req.ID, -32602, err.Error(), nil), true
 		}
@@ -241,7 +241,7 @@ func (h *mcpHandler) handleInitialize(req mcpRequest) (mcpResponse, bool) {
 	}), true
 }
 
-func (h *mcpHandler) handleToolCall(ctx context.Context, params json.RawMessage) (mcpToolResult, error) {
+func (h *mcpHandler) handleToolCall(ctx context.Context, version string, params json.RawMessage) (mcpToolResult, error) {
 	var req struct {
 		Name      string          `json:"name"`
 		Arguments json.RawMessage `json:"arguments"`
@@ -265,7 +265,7 @@ func (h *mcpHandler) handleToolCall(ctx context.Context, params json.RawMessage)
 		if strings.TrimSpace(args.Query) == "" {
 			return mcpToolResult{}, fmt.Errorf("missing required argument: query")
 		}
-		return h.callSearch(ctx, args.Query, args.Prefix), nil
+		return h.callSearch(ctx, version, args.Query, args.Prefix), nil
 	default:
 		return mcpToolResult{}, fmt.Errorf("unknown tool: %s", req.Name)
 	}
@@ -309,7 +309,7 @@ func (h *mcpHandler) callListRepos(ctx context.Context) (mcpToolResult, error) {
 	}, nil
 }
 
-func (h *mcpHandler) callSearch(ctx context.Context, queryText, prefix string) mcpToolResult {
+func (h *mcpHandler) callSearch(ctx context.Context, version, queryText, prefix string) mcpToolResult {
 	fullQuery := strings.TrimSpace(strings.Join([]string{strings.TrimSpace(prefix), strings.TrimSpace(queryText)}, " "))
 	q, err := query.Parse(fullQuery)
 	if err != nil {
@@ -326,6 +326,8 @@ func (h *mcpHandler) callSearch(ctx context.Context, queryText, prefix string) m
 		MaxWallTime:          10 * time.Second,
 		MaxDocDisplayCount:   50,
 		MaxMatchDisplayCount: 200,
+		ChunkMatches:         true,
+		SyntaxTokens:         true,
 	}
 	opts.SetDefaults()
 
@@ -363,6 +365,7 @@ func (h *mcpHandler) callSearch(ctx context.Context, queryText, prefix string) m
 	var output strings.Builder
 	fmt.Fprintf(&output, "Found %d matches in %d files (Query: %s).\n\n", result.Stats.MatchCount, result.Stats.FileCount, fullQuery)
 
+	structured := &mcpSearchResult{Files: []mcpSearchFile{}}
 	for _, file := range result.Files {
 		fileName := file.FileName
 		if repo := reposByName[file.Repository]; repo != nil {
@@ -371,20 +374,53 @@ func (h *mcpHandler) callSearch(ctx context.Context, queryText, prefix string) m
 			}
 		}
 
+		sf := mcpSearchFile{
+			File:       fileName,
+			Repository: file.Repository,
+			Language:   file.Language,
+			Chunks:     []mcpSearchChunk{},
+		}
 		fmt.Fprintf(&output, "File: %s (Repo: %s)\n", fileName, file.Repository)
 		for _, match := range file.LineMatches {
 			line := strings.TrimRight(string(match.Line), "\r\n")
 			fmt.Fprintf(&output, "%d: %s\n", match.LineNumber, line)
+			sf.Chunks = append(sf.Chunks, mcpSearchChunk{Line: match.LineNumber, Content: string(match.Line)})
+		}
+		for _, cm := range file.ChunkMatches {
+			if cm.FileName {
+				continue
+			}
+			lines := strings.SplitAfter(string(cm.Content), "\n")
+			for i, line := range lines {
+				if i == len(lines)-1 && line == "" {
+					break
+				}
+				fmt.Fprintf(&output, "%d: %s\n", int(cm.ContentStart.LineNumber)+i, strings.TrimRight(line, "\r\n"))
+			}
+
+			chunk := mcpSearchChunk{Line: int(cm.ContentStart.LineNumber), Content: string(cm.Content)}
+			for _, tok := range cm.SyntaxTokens {
+				chunk.Tokens = append(chunk.Tokens, mcpSyntaxToken{Start: tok.Start, End: tok.End, Class: tok.Class.String()})
+			}
+			sf.Chunks = append(sf.Chunks, chunk)
 		}
 		output.WriteByte('\n')
+		structured.Files = append(structured.Files, sf)
 	}
 
-	return mcpToolResult{
+	res := mcpToolResult{
 		Content: []mcpToolTextContent{{
 			Type: "text",
 			Text: strings.TrimRight(output.String(), "\n"),
 		}},
 	}
+	// Structured tool output was added in 2025-06-18. It gives clients the
+	// syntax token classes, so they highlight results the same way as the
+	// web UI.
+	if slices.Contains(supportedMCPProtocolVersions[:2], version) {
+		res.StructuredContent = structured
+	}
+	return res
 }
 
 func (h *mcpHandler) tools(version string) []map[string]any {
@@ -557,8 +593,36 @@ type mcpError struct {
 }
 
 type mcpToolResult struct {
-	Content []mcpToolTextContent `json:"content"`
-	IsError bool                 `json:"isError,omitempty"`
+	Content           []mcpToolTextContent `json:"content"`
+	StructuredContent *mcpSearchResult     `json:"structuredContent,omitempty"`
+	IsError           bool                 `json:"isError,omitempty"`
+}
+
+// mcpSearchResult is the structured content of the search tool.
+type mcpSearchResult struct {
+	Files []mcpSearchFile `json:"files"`
+}
+
+type mcpSearchFile struct {
+	File       string           `json:"file"`
+	Repository string           `json:"repository"`
+	Language   string           `json:"language,omitempty"`
+	Chunks     []mcpSearchChunk `json:"chunks"`
+}
+
+// mcpSearchChunk is a range of lines with matches. Line is the number of its
+// first line.
+type mcpSearchChunk struct {
+	Line    int              `json:"line"`
+	Content string           `json:"content"`
+	Tokens  []mcpSyntaxToken `json:"tokens,omitempty"`
+}
+
+// mcpSyntaxToken classifies the bytes Start to End of the chunk content.
+type mcpSyntaxToken struct {
+	Start uint32 `json:"start"`
+	End   uint32 `json:"end"`
+	Class string `json:"class"`
 }
 
 type mcpToolTextContent struct {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -153,6 +154,83 @@ func TestMCPSearchToolCall(t *testing.T) {
 	}
 }
 
+func TestMCPSearchToolCallSyntaxTokens(t *testing.T) {
+	handler := newMCPHandler(fakeStreamer{
+		searchResult: &zoekt.SearchResult{
+			Stats: zoekt.Stats{
+				FileCount:  1,
+				MatchCount: 2,
+			},
+			Files: []zoekt.FileMatch{
+				{
+					FileName:   "main.go",
+					Repository: "repo-a",
+					Language:   "Go",
+					ChunkMatches: []zoekt.ChunkMatch{{
+						Content:      []byte("func initContext() {\n\tinitLogs()\n"),
+						ContentStart: zoekt.Location{ByteOffset: 100, LineNumber: 12, Column: 1},
+						SyntaxTokens: []zoekt.SyntaxToken{
+							{Start: 0, End: 4, Class: zoekt.TokenKeyword},
+							{Start: 5, End: 16, Class: zoekt.TokenIdentifier},
+						},
+					}},
+				},
+			},
+		},
+	}, "zoekt-webserver", "dev", nil)
+
+	for _, version := range []string{"2025-06-18", "2025-03-26"} {
+		resp := postMCPWithVersion(t, handler, version, `{
+			"jsonrpc":"2.0",
+			"id":1,
+			"method":"tools/call",
+			"params":{"name":"search","arguments":{"query":"init"}}
+		}`)
+		if got := resp.Code; got != http.StatusOK {
+			t.Fatalf("tools/call status=%d want %d", got, http.StatusOK)
+		}
+
+		var body struct {
+			Result mcpToolResult `json:"result"`
+		}
+		decodeBody(t, resp, &body)
+
+		text := body.Result.Content[0].Text
+		for _, want := range []string{
+			"12: func initContext() {",
+			"13: \tinitLogs()",
+		} {
+			if !strings.Contains(text, want) {
+				t.Fatalf("result missing %q in %q", want, text)
+			}
+		}
+
+		if version == "2025-03-26" {
+			if body.Result.StructuredContent != nil {
+				t.Fatalf("got structured content for %s: %+v", version, body.Result.StructuredContent)
+			}
+			continue
+		}
+
+		want := &mcpSearchResult{Files: []mcpSearchFile{{
+			File:       "main.go",
+			Repository: "repo-a",
+			Language:   "Go",
+			Chunks: []mcpSearchChunk{{
+				Line:    12,
+				Content: "func initContext() {\n\tinitLogs()\n",
+				Tokens: []mcpSyntaxToken{
+					{Start: 0, End: 4, Class: "keyword"},
+					{Start: 5, End: 16, Class: "identifier"},
+				},
+			}},
+		}}}
+		if !reflect.DeepEqual(body.Result.StructuredContent, want) {
+			t.Fatalf("got structured content %+v, want %+v", body.Result.StructuredContent, want)
+		}
+	}
+}
+
 func TestMCPSearchToolCallResolvesLocalFilePath(t *testing.T) {
 	root := filepath.Join(t.TempDir(), "Engine")
 	repo := zoekt.Repository{Name: "repo-a"}
@@ -242,6 +320,18 @@ func (f fakeStreamer) List(_ context.Context, _ query.Q, _ *zoekt.ListOptions) (
 	return f.listResult, f.listErr
 }
 
+func (f fakeStreamer) References(context.Context, string, *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	return f.searchResult, f.searchErr
+}
+
+func (fakeStreamer) Explain(context.Context, query.Q, *zoekt.SearchOptions) (*zoekt.Explanation, error) {
+	return &zoekt.Explanation{}, nil
+}
+
+func (fakeStreamer) Clones(context.Context, []byte, *zoekt.CloneOptions) (*zoekt.CloneResult, error) {
+	return &zoekt.CloneResult{}, nil
+}
+
 func (fakeStreamer) Close() {}
 
 func (fakeStreamer) String() string { return "fakeStreamer" }
@@ -62,6 +62,35 @@ func displayMatchesJSONL(files []zoekt.FileMatch) {
 	}
 }
 
+func displayExplanation(ex *zoekt.Explanation) {
+	fmt.Printf("query: %s\n", ex.Query)
+	for _, s := range ex.SkippedShards {
+		fmt.Printf("skipped shard: %s\n", s)
+	}
+	for _, s := range ex.Shards {
+		fmt.Printf("shard: %s (%v)\n", s.Shard, s.Duration)
+		fmt.Printf("  query: %s\n", s.Query)
+		if s.MatchTree == "" {
+			fmt.Printf("  matchtree: none, the shard can't match\n")
+		} else {
+			fmt.Printf("  matchtree: %s\n", s.MatchTree)
+		}
+		for _, n := range s.Ngrams {
+			var ngrams []string
+			for i, ng := range n.Ngrams {
+				ngrams = append(ngrams, fmt.Sprintf("%q (%d bytes)", ng, n.PostingListSizes[i]))
+			}
+			where := "content"
+			if n.FileName {
+				where = "file name"
+			}
+			fmt.Printf("  ngrams of %s %q: %s\n", where, n.Pattern, strings.Join(ngrams, ", "))
+		}
+		fmt.Printf("  files considered: %d, matches: %d\n", s.Stats.FilesConsidered, s.Stats.MatchCount)
+	}
+	fmt.Printf("duration: %v\n", ex.Duration)
+}
+
 func addTabIfNonEmpty(s string) string {
 	if s != "" {
 		return "\t" + s
@@ -175,6 +204,7 @@ func main() {
 	list := flag.Bool("l", false, "print matching filenames only")
 	jsonl := flag.Bool("jsonl", false, "print results in jsonl format")
 	sym := flag.Bool("sym", false, "do experimental symbol search")
+	explain := flag.Bool("explain", false, "print how the shards evaluate the query instead of the results")
 
 	flag.Usage = func() {
 		name := os.Args[0]
@@ -224,6 +254,20 @@ func main() {
 	sOpts := zoekt.SearchOptions{
 		DebugScore: *debug,
 	}
+
+	if *explain {
+		ex, err := searcher.Explain(context.Background(), q, &sOpts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *jsonl {
+			json.NewEncoder(os.Stdout).Encode(ex)
+		} else {
+			displayExplanation(ex)
+		}
+		return
+	}
+
 	sres, err := searcher.Search(context.Background(), q, &sOpts)
 	if err != nil {
 		log.Fatal(err)
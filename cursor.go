@@ -0,0 +1,59 @@
+package zoekt
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Cursor is the position of a file match in paginated search results, which
+// are ordered by descending score, then by repository and file name. The
+// repository and file name identify the document independently of the shard
+// that contains it, so a cursor stays valid when shards are reloaded or
+// ranked differently.
+type Cursor struct {
+	Score      float64
+	Repository string
+	FileName   string
+}
+
+// CursorFor returns the position of fm.
+func CursorFor(fm *FileMatch) Cursor {
+	return Cursor{Score: fm.Score, Repository: fm.Repository, FileName: fm.FileName}
+}
+
+// Compare returns -1 if c comes before o in paginated search results, 1 if
+// it comes after o and 0 if they are at the same position.
+func (c Cursor) Compare(o Cursor) int {
+	if c.Score != o.Score {
+		// Higher scores come first.
+		return cmp.Compare(o.Score, c.Score)
+	}
+	if r := cmp.Compare(c.Repository, o.Repository); r != 0 {
+		return r
+	}
+	return cmp.Compare(c.FileName, o.FileName)
+}
+
+// Encode returns the opaque form of c used by SearchResult.Cursor and
+// SearchOptions.Cursor.
+func (c Cursor) Encode() string {
+	// JSON encodes float64 such that it decodes to the same value, which
+	// matters because scores are compared for equality.
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseCursor parses a cursor returned by Cursor.Encode.
+func ParseCursor(s string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor %q: %w", s, err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor %q: %w", s, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,38 @@
+package zoekt
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	ordered := []Cursor{
+		{Score: math.MaxFloat64, Repository: "b", FileName: "a"},
+		{Score: 1.0 / 3, Repository: "a", FileName: "z"},
+		{Score: 1.0 / 3, Repository: "b", FileName: "a"},
+		{Score: 1.0 / 3, Repository: "b", FileName: "b"},
+		{Score: 0, Repository: "a", FileName: "a"},
+	}
+	if !slices.IsSortedFunc(ordered, Cursor.Compare) {
+		t.Fatalf("%v is not sorted", ordered)
+	}
+	for i, c := range ordered {
+		got, err := ParseCursor(c.Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c {
+			t.Errorf("got %v, want %v", got, c)
+		}
+		if c.Compare(ordered[i]) != 0 {
+			t.Errorf("%v doesn't compare equal to itself", c)
+		}
+	}
+
+	for _, s := range []string{"not base64!", "bm90IGpzb24"} {
+		if _, err := ParseCursor(s); err == nil {
+			t.Errorf("ParseCursor(%q): got no error", s)
+		}
+	}
+}
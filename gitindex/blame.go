@@ -0,0 +1,79 @@
+package gitindex
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+)
+
+// blamer computes the blame of files at the tip of the indexed branches.
+type blamer struct {
+	// commits maps branch names to the commit they point to.
+	commits map[string]*object.Commit
+}
+
+// newBlamer returns a blamer for the branches of the repository. Branches
+// that aren't commits, like the working tree branch, are skipped.
+func newBlamer(repo *git.Repository, branches []zoekt.RepositoryBranch, skip string) (*blamer, error) {
+	b := &blamer{commits: map[string]*object.Commit{}}
+	for _, br := range branches {
+		if br.Name == skip {
+			continue
+		}
+		c, err := repo.CommitObject(plumbing.NewHash(br.Version))
+		if err != nil {
+			return nil, fmt.Errorf("blame: resolving %s: %w", br.Name, err)
+		}
+		b.commits[br.Name] = c
+	}
+	return b, nil
+}
+
+// blame returns the blame of the file at key, as of the first of branches
+// that is a commit. It returns nil if the blame can't be computed, which
+// includes files from submodules.
+func (b *blamer) blame(key fileKey, branches []string) []index.BlameHunk {
+	if key.SubRepoPath != "" {
+		return nil
+	}
+
+	var commit *object.Commit
+	for _, br := range branches {
+		if c, ok := b.commits[br]; ok {
+			commit = c
+			break
+		}
+	}
+	if commit == nil {
+		return nil
+	}
+
+	res, err := git.Blame(commit, key.Path)
+	if err != nil {
+		log.Printf("blame %s@%s: %v", key.Path, commit.Hash, err)
+		return nil
+	}
+
+	var hunks []index.BlameHunk
+	for _, l := range res.Lines {
+		if n := len(hunks); n > 0 && hunks[n-1].Commit == l.Hash.String() {
+			hunks[n-1].Lines++
+			continue
+		}
+		hunks = append(hunks, index.BlameHunk{
+			Lines: 1,
+			Blame: zoekt.Blame{
+				Commit: l.Hash.String(),
+				Author: fmt.Sprintf("%s <%s>", l.AuthorName, l.Author),
+				Date:   l.Date,
+			},
+		})
+	}
+	return hunks
+}
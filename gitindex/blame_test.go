@@ -0,0 +1,154 @@
+package gitindex
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/search"
+)
+
+func TestIndexBlame(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+
+	commit := func(author, date, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoDir, "main.go"), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		executeCommand(t, repoDir, exec.Command("git", "add", "."))
+		executeCommand(t, repoDir, exec.Command("env",
+			"GIT_AUTHOR_NAME="+author,
+			"GIT_AUTHOR_DATE="+date,
+			"GIT_COMMITTER_DATE="+date,
+			"git", "commit", "-m", "change"))
+	}
+
+	commit("Alice", "2020-01-01T00:00:00Z", "package main\n\nfunc old() {}\n")
+	commit("Bob", "2024-01-01T00:00:00Z", "package main\n\nfunc old() {}\nfunc recent() {}\n")
+
+	opts := Options{
+		RepoDir:  repoDir,
+		Branches: []string{"main"},
+		Blame:    true,
+		BuildOptions: index.Options{
+			RepositoryDescription: zoekt.Repository{Name: "repo"},
+			IndexDir:              indexDir,
+			DisableCTags:          true,
+		},
+	}
+	if _, err := IndexGitRepo(opts); err != nil {
+		t.Fatal(err)
+	}
+
+	searcher, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Substring{Pattern: "func", Content: true}, &zoekt.SearchOptions{Blame: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 {
+		t.Fatalf("got %d files, want 1", len(res.Files))
+	}
+	authors := map[int]string{}
+	for _, lm := range res.Files[0].LineMatches {
+		if lm.Blame == nil {
+			t.Fatalf("line %d has no blame", lm.LineNumber)
+		}
+		authors[lm.LineNumber] = lm.Blame.Author
+	}
+	if got, want := authors[3], "Alice <soren@apache.com>"; got != want {
+		t.Errorf("line 3: got author %q, want %q", got, want)
+	}
+	if got, want := authors[4], "Bob <soren@apache.com>"; got != want {
+		t.Errorf("line 4: got author %q, want %q", got, want)
+	}
+
+	for _, tc := range []struct {
+		q     string
+		count int
+	}{
+		{"modified:>2023-06-01", 1},
+		{"modified:<2023-06-01", 0},
+	} {
+		q, err := query.Parse(tc.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != tc.count {
+			t.Errorf("%s: got %d files, want %d", tc.q, len(res.Files), tc.count)
+		}
+	}
+}
+
+func TestIndexBlameIncremental(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+	if err := os.WriteFile(filepath.Join(repoDir, "main.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	executeCommand(t, repoDir, exec.Command("git", "add", "."))
+	executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial"))
+
+	build := func(blame, wantUpdated bool) {
+		t.Helper()
+		opts := Options{
+			RepoDir:     repoDir,
+			Branches:    []string{"main"},
+			Incremental: true,
+			Blame:       blame,
+			BuildOptions: index.Options{
+				RepositoryDescription: zoekt.Repository{Name: "repo"},
+				IndexDir:              indexDir,
+				DisableCTags:          true,
+			},
+		}
+		updated, err := IndexGitRepo(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated != wantUpdated {
+			t.Fatalf("blame=%t: got updated %t, want %t", blame, updated, wantUpdated)
+		}
+	}
+
+	build(false, true)
+	build(false, false)
+	build(true, true)
+	build(true, false)
+
+	searcher, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Substring{Pattern: "package", Content: true}, &zoekt.SearchOptions{Blame: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 || len(res.Files[0].LineMatches) != 1 {
+		t.Fatalf("got %+v, want one line match", res.Files)
+	}
+	if res.Files[0].LineMatches[0].Blame == nil {
+		t.Errorf("got no blame after enabling blame")
+	}
+}
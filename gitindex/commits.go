@@ -0,0 +1,195 @@
+package gitindex
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/sourcegraph/zoekt/index"
+)
+
+// commitLanguage is the language of commit documents. Their content is
+// the commit header and message followed by a unified diff.
+const commitLanguage = "Diff"
+
+// historyCommit is a commit reachable from one or more indexed branches.
+type historyCommit struct {
+	commit   *object.Commit
+	branches []string
+}
+
+// collectCommits returns the commits reachable from the given branches,
+// newest first. If maxCommits is positive, only the maxCommits newest commits
+// are returned.
+func collectCommits(repo *git.Repository, branches []string, versions map[string]plumbing.Hash, maxCommits int) ([]*historyCommit, error) {
+	byHash := map[plumbing.Hash]*historyCommit{}
+	for _, b := range branches {
+		iter, err := repo.Log(&git.LogOptions{
+			From:  versions[b],
+			Order: git.LogOrderCommitterTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("repo.Log(%s): %w", b, err)
+		}
+
+		n := 0
+		err = iter.ForEach(func(c *object.Commit) error {
+			if maxCommits > 0 && n >= maxCommits {
+				return storer.ErrStop
+			}
+			n++
+
+			hc, ok := byHash[c.Hash]
+			if !ok {
+				hc = &historyCommit{commit: c}
+				byHash[c.Hash] = hc
+			}
+			hc.branches = append(hc.branches, b)
+			return nil
+		})
+		iter.Close()
+		if err != nil {
+			return nil, fmt.Errorf("walking history of %s: %w", b, err)
+		}
+	}
+
+	commits := make([]*historyCommit, 0, len(byHash))
+	for _, hc := range byHash {
+		commits = append(commits, hc)
+	}
+	sort.Slice(commits, func(i, j int) bool {
+		ci, cj := commits[i].commit, commits[j].commit
+		if !ci.Committer.When.Equal(cj.Committer.When) {
+			return ci.Committer.When.After(cj.Committer.When)
+		}
+		return ci.Hash.String() < cj.Hash.String()
+	})
+	if maxCommits > 0 && len(commits) > maxCommits {
+		commits = commits[:maxCommits]
+	}
+	return commits, nil
+}
+
+// createCommitDocument returns the document for a commit. Its name is the
+// commit ID, and its content resembles the output of git show: a header,
+// the commit message and the diff against the first parent. If the diff
+// makes the document larger than sizeMax, it is replaced by the list of
+// changed paths.
+func createCommitDocument(hc *historyCommit, sizeMax int) (index.Document, error) {
+	c := hc.commit
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "commit %s\nAuthor: %s\nDate:   %s\n\n", c.Hash, c.Author.String(), c.Author.When.Format(object.DateFormat))
+	msgStart := sb.Len()
+	sb.WriteString(c.Message)
+	msgEnd := sb.Len()
+	if !strings.HasSuffix(c.Message, "\n") {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+
+	patch, err := commitPatch(c)
+	if err != nil {
+		return index.Document{}, fmt.Errorf("diff of commit %s: %w", c.Hash, err)
+	}
+
+	diff := patch.String()
+	if sizeMax > 0 && sb.Len()+len(diff) > sizeMax {
+		var paths strings.Builder
+		for _, fp := range patch.FilePatches() {
+			from, to := fp.Files()
+			switch {
+			case from == nil:
+				fmt.Fprintf(&paths, "A\t%s\n", to.Path())
+			case to == nil:
+				fmt.Fprintf(&paths, "D\t%s\n", from.Path())
+			case from.Path() != to.Path():
+				fmt.Fprintf(&paths, "R\t%s\t%s\n", from.Path(), to.Path())
+			default:
+				fmt.Fprintf(&paths, "M\t%s\n", to.Path())
+			}
+		}
+		diff = paths.String()
+	}
+	sb.WriteString(diff)
+
+	return index.Document{
+		Name:     c.Hash.String(),
+		Content:  []byte(sb.String()),
+		Branches: hc.branches,
+		Language: commitLanguage,
+		Commit: &index.Commit{
+			Author:       c.Author.String(),
+			Date:         c.Committer.When,
+			MessageStart: uint32(msgStart),
+			MessageEnd:   uint32(msgEnd),
+		},
+	}, nil
+}
+
+// commitPatch returns the patch between the first parent of c, or the empty
+// tree for root commits, and c.
+func commitPatch(c *object.Commit) (*object.Patch, error) {
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	parentTree := &object.Tree{}
+	if c.NumParents() > 0 {
+		parent, err := c.Parents().Next()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if parent != nil {
+			if parentTree, err = parent.Tree(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return parentTree.Patch(tree)
+}
+
+// indexCommits adds a document for each commit reachable from the indexed
+// branches to builder. The working tree branch has no history of its own.
+func indexCommits(opts Options, repo *git.Repository, builder *index.Builder) error {
+	var branches []string
+	versions := map[string]plumbing.Hash{}
+	for _, br := range opts.BuildOptions.RepositoryDescription.Branches {
+		if br.Name == opts.WorkTreeBranch {
+			continue
+		}
+		branches = append(branches, br.Name)
+		versions[br.Name] = plumbing.NewHash(br.Version)
+	}
+
+	commits, err := collectCommits(repo, branches, versions, opts.MaxCommits)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("attempting to index %d commits", len(commits))
+	for idx, hc := range commits {
+		doc, err := createCommitDocument(hc, opts.BuildOptions.SizeMax)
+		if err != nil {
+			return err
+		}
+		if err := builder.Add(doc); err != nil {
+			return fmt.Errorf("error adding commit %s: %w", doc.Name, err)
+		}
+
+		if idx%10_000 == 0 {
+			builder.CheckMemoryUsage()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package gitindex
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/search"
+)
+
+func TestIndexCommits(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+
+	var shas []string
+	commit := func(author, date, message, name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		executeCommand(t, repoDir, exec.Command("git", "add", "."))
+		executeCommand(t, repoDir, exec.Command("env",
+			"GIT_AUTHOR_NAME="+author,
+			"GIT_AUTHOR_DATE="+date,
+			"GIT_COMMITTER_DATE="+date,
+			"git", "commit", "-m", message))
+
+		out, err := exec.Command("git", "-C", repoDir, "rev-parse", "HEAD").Output()
+		if err != nil {
+			t.Fatalf("rev-parse: %v", err)
+		}
+		shas = append(shas, string(out[:40]))
+	}
+
+	commit("Alice", "2024-01-10T12:00:00Z", "Add the frobnicator", "main.go", "func frobnicate() {}\n")
+	commit("Bob", "2024-03-10T12:00:00Z", "Rename frobnicator\n\nFixes a typo.", "main.go", "func frobnicateAll() {}\n")
+	commit("Alice", "2024-05-10T12:00:00Z", "Remove the frobnicator", "main.go", "func main() {}\n")
+
+	opts := Options{
+		RepoDir:      repoDir,
+		Branches:     []string{"main"},
+		IndexCommits: true,
+		BuildOptions: index.Options{
+			RepositoryDescription: zoekt.Repository{Name: "repo"},
+			IndexDir:              indexDir,
+			DisableCTags:          true,
+		},
+	}
+	if _, err := IndexGitRepo(opts); err != nil {
+		t.Fatal(err)
+	}
+
+	searcher, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	tests := []struct {
+		q    string
+		want []string
+	}{
+		{q: "frobnicate", want: nil},
+		{q: "main", want: []string{"main.go"}},
+		{q: "type:commit frobnicateAll", want: []string{shas[1], shas[2]}},
+		{q: "type:commit author:alice", want: []string{shas[0], shas[2]}},
+		{q: "type:commit author:Bob", want: []string{shas[1]}},
+		{q: "type:commit message:typo", want: []string{shas[1]}},
+		{q: "type:commit message:func", want: nil},
+		{q: "type:commit after:2024-02-01", want: []string{shas[1], shas[2]}},
+		{q: "type:commit before:2024-02-01", want: []string{shas[0]}},
+		{q: "type:commit after:2024-02-01 before:2024-04-01 frobnicate", want: []string{shas[1]}},
+		{q: `type:commit "-func frobnicateAll"`, want: []string{shas[2]}},
+		{q: "type:commit -author:alice", want: []string{shas[1]}},
+		{q: "author:alice", want: []string{shas[0], shas[2]}},
+		{q: `type:commit "b/main.go"`, want: []string{shas[0], shas[1], shas[2]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.q, func(t *testing.T) {
+			q, err := query.Parse(tt.q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, f := range res.Files {
+				got = append(got, f.FileName)
+			}
+			slices.Sort(got)
+			want := slices.Clone(tt.want)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIndexCommitsIncremental(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+	for _, content := range []string{"one\n", "two\n", "three\n"} {
+		if err := os.WriteFile(filepath.Join(repoDir, "main.go"), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		executeCommand(t, repoDir, exec.Command("git", "add", "."))
+		executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "change"))
+	}
+
+	build := func(indexCommits bool, maxCommits int, wantUpdated bool, wantCommits int) {
+		t.Helper()
+		opts := Options{
+			RepoDir:      repoDir,
+			Branches:     []string{"main"},
+			Incremental:  true,
+			IndexCommits: indexCommits,
+			MaxCommits:   maxCommits,
+			BuildOptions: index.Options{
+				RepositoryDescription: zoekt.Repository{Name: "repo"},
+				IndexDir:              indexDir,
+				DisableCTags:          true,
+			},
+		}
+		updated, err := IndexGitRepo(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated != wantUpdated {
+			t.Fatalf("IndexCommits=%t MaxCommits=%d: got updated %t, want %t", indexCommits, maxCommits, updated, wantUpdated)
+		}
+
+		searcher, err := search.NewDirectorySearcher(indexDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer searcher.Close()
+
+		q, err := query.Parse("type:commit main.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != wantCommits {
+			t.Errorf("IndexCommits=%t MaxCommits=%d: got %d commits, want %d", indexCommits, maxCommits, len(res.Files), wantCommits)
+		}
+	}
+
+	build(false, 0, true, 0)
+	build(true, 0, true, 3)
+	build(true, 0, false, 3)
+	build(true, 1, true, 1)
+	build(false, 0, true, 0)
+}
+
+func TestCreateCommitDocumentSizeMax(t *testing.T) {
+	dir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+	if err := os.WriteFile(filepath.Join(repoDir, "big.txt"), []byte("lots of content\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	executeCommand(t, repoDir, exec.Command("git", "add", "."))
+	executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial commit"))
+
+	repo, err := git.PlainOpen(repoDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commits, err := collectCommits(repo, []string{"main"}, map[string]plumbing.Hash{"main": head.Hash()}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("got %d commits, want 1", len(commits))
+	}
+
+	doc, err := createCommitDocument(commits[0], 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(doc.Content[doc.Commit.MessageStart:doc.Commit.MessageEnd]), "initial commit\n"; got != want {
+		t.Errorf("got message %q, want %q", got, want)
+	}
+	if want := "A\tbig.txt\n"; !strings.HasSuffix(string(doc.Content), want) {
+		t.Errorf("got content %q, want changed paths only", doc.Content)
+	}
+}
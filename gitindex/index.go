@@ -38,6 +38,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/cache"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/go-git/go-git/v5/storage/filesystem/dotgit"
 
 	"github.com/sourcegraph/zoekt"
 	"github.com/sourcegraph/zoekt/ignore"
@@ -368,6 +369,39 @@ type Options struct {
 	// If DeltaShardNumberFallbackThreshold is 0, then this fallback behavior is disabled:
 	// a delta build will always be performed regardless of the number of preexisting shards.
 	DeltaShardNumberFallbackThreshold uint64
+
+	// WorkTreeBranch, if set, is the name of a virtual branch holding the
+	// working tree of RepoDir: the files of HEAD with uncommitted changes and
+	// untracked files applied on top. Files ignored by git are left out. See
+	// DefaultWorkTreeBranch.
+	WorkTreeBranch string
+
+	// IndexCommits, if set, also indexes the history of the branches. Each
+	// commit becomes a document holding its message and diff, which is
+	// only returned by commit queries (type:commit).
+	IndexCommits bool
+
+	// MaxCommits limits the number of commits indexed if IndexCommits is
+	// set. The newest commits are kept. 0 means no limit.
+	MaxCommits int
+
+	// Blame, if set, records for every line of the indexed files the commit
+	// that last changed it. Files of the working tree branch and of
+	// submodules are indexed without blame.
+	Blame bool
+}
+
+// sourceOptions returns the options that change which data is indexed
+// besides the files, for index.Options.SourceOptions.
+func (o *Options) sourceOptions() []string {
+	var so []string
+	if o.IndexCommits {
+		so = append(so, fmt.Sprintf("commits:%d", o.MaxCommits))
+	}
+	if o.Blame {
+		so = append(so, "blame")
+	}
+	return so
 }
 
 func expandBranches(repo *git.Repository, bs []string, prefix string) ([]string, error) {
@@ -447,6 +481,7 @@ func indexGitRepo(opts Options, config gitIndexConfig) (bool, error) {
 	}
 
 	opts.BuildOptions.RepositoryDescription.Source = opts.RepoDir
+	opts.BuildOptions.SourceOptions = opts.sourceOptions()
 
 	var repo *git.Repository
 	legacyRepoOpen := cmp.Or(os.Getenv("ZOEKT_DISABLE_GOGIT_OPTIMIZATION"), "false")
@@ -492,6 +527,23 @@ func indexGitRepo(opts Options, config gitIndexConfig) (bool, error) {
 		}
 	}
 
+	var wt *workTree
+	if opts.WorkTreeBranch != "" {
+		wt, err = readWorkTree(repo)
+		if err != nil {
+			return false, fmt.Errorf("readWorkTree: %w", err)
+		}
+
+		opts.BuildOptions.RepositoryDescription.Branches = append(opts.BuildOptions.RepositoryDescription.Branches, zoekt.RepositoryBranch{
+			Name:    opts.WorkTreeBranch,
+			Version: wt.version(),
+		})
+
+		if when := wt.head.Committer.When; when.After(opts.BuildOptions.RepositoryDescription.LatestCommitDate) {
+			opts.BuildOptions.RepositoryDescription.LatestCommitDate = when
+		}
+	}
+
 	if opts.Incremental && opts.BuildOptions.IncrementalSkipIndexing() {
 		return false, nil
 	}
@@ -521,6 +573,14 @@ func indexGitRepo(opts Options, config gitIndexConfig) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("preparing normal build: %w", err)
 		}
+
+		if wt != nil {
+			subVersions, err := wt.collectFiles(repo, opts.BuildOptions.RepositoryDescription.URL, opts.WorkTreeBranch, repos)
+			if err != nil {
+				return false, fmt.Errorf("collecting working tree files: %w", err)
+			}
+			branchVersions[opts.WorkTreeBranch] = subVersions
+		}
 	}
 
 	reposByPath := map[string]BlobLocation{}
@@ -585,6 +645,14 @@ func indexGitRepo(opts Options, config gitIndexConfig) (bool, error) {
 	sort.Strings(names)
 	names = uniq(names)
 
+	var blamer *blamer
+	if opts.Blame {
+		blamer, err = newBlamer(repo, opts.BuildOptions.RepositoryDescription.Branches, opts.WorkTreeBranch)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	log.Printf("attempting to index %d total files", totalFiles)
 	for idx, name := range names {
 		keys := fileKeys[name]
@@ -595,6 +663,10 @@ func indexGitRepo(opts Options, config gitIndexConfig) (bool, error) {
 				return false, err
 			}
 
+			if blamer != nil && doc.SkipReason == index.SkipReasonNone && repos[key].WorkTreePath == "" {
+				doc.Blame = blamer.blame(key, doc.Branches)
+			}
+
 			if err := builder.Add(doc); err != nil {
 				return false, fmt.Errorf("error adding document with name %s: %w", key.FullPath(), err)
 			}
@@ -604,6 +676,12 @@ func indexGitRepo(opts Options, config gitIndexConfig) (bool, error) {
 			}
 		}
 	}
+
+	if opts.IndexCommits {
+		if err := indexCommits(opts, repo, builder); err != nil {
+			return false, err
+		}
+	}
 	return true, builder.Finish()
 }
 
@@ -627,6 +705,15 @@ func openRepo(repoDir string) (*git.Repository, io.Closer, error) {
 		if fs, err = fs.Chroot(git.GitDirName); err != nil {
 			return nil, nil, fmt.Errorf("fs.Chroot: %w", err)
 		}
+	} else if err == nil {
+		// In linked worktrees and submodules, .git is a file pointing to the
+		// git directory. Linked worktrees share the objects and refs of the
+		// common git directory.
+		gitDir, commonDir := ignore.GitDirs(repoDir)
+		if gitDir == "" {
+			return nil, nil, git.ErrRepositoryNotExists
+		}
+		fs = dotgit.NewRepositoryFilesystem(osfs.New(gitDir), osfs.New(commonDir))
 	}
 
 	s := filesystem.NewStorageWithOptions(fs, cache.NewObjectLRUDefault(), filesystem.Options{
@@ -681,6 +768,18 @@ func prepareDeltaBuild(options Options, repository *git.Repository) (repos map[f
 		return nil, nil, nil, fmt.Errorf("delta builds currently don't support submodule indexing")
 	}
 
+	if options.WorkTreeBranch != "" {
+		return nil, nil, nil, fmt.Errorf("delta builds currently don't support indexing the working tree")
+	}
+
+	if options.IndexCommits {
+		return nil, nil, nil, fmt.Errorf("delta builds currently don't support indexing commits")
+	}
+
+	if options.Blame {
+		return nil, nil, nil, fmt.Errorf("delta builds currently don't support blame")
+	}
+
 	// discover what commits we indexed during our last build
 	existingRepository, _, ok, err := options.BuildOptions.FindRepositoryMetadata()
 	if err != nil {
@@ -982,6 +1081,10 @@ func createDocument(key fileKey,
 	opts index.Options,
 ) (index.Document, error) {
 	repo := repos[key]
+	if repo.WorkTreePath != "" {
+		return createWorkTreeDocument(key, repo, opts)
+	}
+
 	blob, err := repo.GitRepo.BlobObject(key.ID)
 	branches := repos[key].Branches
 
@@ -1012,6 +1115,31 @@ func createDocument(key fileKey,
 	}, nil
 }
 
+// createWorkTreeDocument is like createDocument for blobs that are read from
+// the working tree.
+func createWorkTreeDocument(key fileKey, repo BlobLocation, opts index.Options) (index.Document, error) {
+	keyFullPath := key.FullPath()
+	info, err := os.Stat(repo.WorkTreePath)
+	if err != nil {
+		return index.Document{}, err
+	}
+	if info.Size() > int64(opts.SizeMax) && !opts.IgnoreSizeMax(keyFullPath) {
+		return skippedLargeDoc(key, repo.Branches), nil
+	}
+
+	contents, err := os.ReadFile(repo.WorkTreePath)
+	if err != nil {
+		return index.Document{}, err
+	}
+
+	return index.Document{
+		SubRepositoryPath: key.SubRepoPath,
+		Name:              keyFullPath,
+		Content:           contents,
+		Branches:          repo.Branches,
+	}, nil
+}
+
 func skippedLargeDoc(key fileKey, branches []string) index.Document {
 	return index.Document{
 		SkipReason:        index.SkipReasonTooLarge,
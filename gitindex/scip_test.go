@@ -0,0 +1,187 @@
+package gitindex
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+	"github.com/sourcegraph/zoekt/internal/scip"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/search"
+)
+
+func TestIndexSCIP(t *testing.T) {
+	const sprintf = "scip-go gomod std . fmt/Sprintf()."
+
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+
+	// Both repositories call fmt.Sprintf and define their own function.
+	for _, name := range []string{"a", "b"} {
+		executeCommand(t, dir, exec.Command("git", "init", "-b", "main", name))
+		repoDir := filepath.Join(dir, name)
+		content := "package main\n\nfunc " + name + "() string { return fmt.Sprintf(\"\") }\n"
+		if err := os.WriteFile(filepath.Join(repoDir, "main.go"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		executeCommand(t, repoDir, exec.Command("git", "add", "."))
+		executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial"))
+
+		def := "scip-go gomod " + name + " v1 `" + name + "`/" + name + "()."
+		idx := &scip.Index{Documents: []*scip.Document{{
+			RelativePath:     "main.go",
+			PositionEncoding: scip.PositionEncodingUTF8,
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{2, 5, 6}, Symbol: def, SymbolRoles: scip.SymbolRoleDefinition},
+				{Range: []int32{2, 29, 36}, Symbol: sprintf},
+			},
+		}}}
+		scipPath := filepath.Join(dir, name+".scip")
+		if err := os.WriteFile(scipPath, idx.Marshal(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		opts := Options{
+			RepoDir:  repoDir,
+			Branches: []string{"main"},
+			BuildOptions: index.Options{
+				RepositoryDescription: zoekt.Repository{Name: name},
+				IndexDir:              indexDir,
+				DisableCTags:          true,
+				SCIPIndex:             scipPath,
+			},
+		}
+		if _, err := IndexGitRepo(opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	searcher, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	matches := func(res *zoekt.SearchResult) []string {
+		var got []string
+		for _, f := range res.Files {
+			for _, lm := range f.LineMatches {
+				for _, fr := range lm.LineFragments {
+					got = append(got, f.Repository+":"+string(lm.Line[fr.LineOffset:fr.LineOffset+fr.MatchLength]))
+				}
+			}
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	q, err := query.Parse("def:^a$")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff([]string{"a:a"}, matches(res)); d != "" {
+		t.Errorf("def:^a$: mismatch (-want +got):\n%s", d)
+	}
+
+	res, err = searcher.References(context.Background(), sprintf, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff([]string{"a:Sprintf", "b:Sprintf"}, matches(res)); d != "" {
+		t.Errorf("References: mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestIndexSCIPIncremental(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+	if err := os.WriteFile(filepath.Join(repoDir, "main.go"), []byte("package main\n\nfunc a() {}\nfunc b() {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	executeCommand(t, repoDir, exec.Command("git", "add", "."))
+	executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial"))
+
+	scipPath := filepath.Join(dir, "index.scip")
+	writeSCIP := func(line int32, name string, mtime time.Time) {
+		t.Helper()
+		idx := &scip.Index{Documents: []*scip.Document{{
+			RelativePath:     "main.go",
+			PositionEncoding: scip.PositionEncodingUTF8,
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{line, 5, 6}, Symbol: "scip-go gomod repo v1 `repo`/" + name + "().", SymbolRoles: scip.SymbolRoleDefinition},
+			},
+		}}}
+		if err := os.WriteFile(scipPath, idx.Marshal(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(scipPath, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	build := func(scipIndex string, wantUpdated bool, wantDef string) {
+		t.Helper()
+		opts := Options{
+			RepoDir:     repoDir,
+			Branches:    []string{"main"},
+			Incremental: true,
+			BuildOptions: index.Options{
+				RepositoryDescription: zoekt.Repository{Name: "repo"},
+				IndexDir:              indexDir,
+				DisableCTags:          true,
+				SCIPIndex:             scipIndex,
+			},
+		}
+		updated, err := IndexGitRepo(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated != wantUpdated {
+			t.Fatalf("SCIPIndex=%q: got updated %t, want %t", scipIndex, updated, wantUpdated)
+		}
+		if wantDef == "" {
+			return
+		}
+
+		searcher, err := search.NewDirectorySearcher(indexDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer searcher.Close()
+
+		q, err := query.Parse("def:^" + wantDef + "$")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != 1 {
+			t.Errorf("def:^%s$: got %d files, want 1", wantDef, len(res.Files))
+		}
+	}
+
+	mtime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	writeSCIP(2, "a", mtime)
+	build("", true, "")
+	build(scipPath, true, "a")
+	build(scipPath, false, "a")
+
+	writeSCIP(3, "b", mtime.Add(time.Hour))
+	build(scipPath, true, "b")
+}
@@ -233,6 +233,10 @@ type BlobLocation struct {
 
 	// Branches is the list of branches that contain the blob.
 	Branches []string
+
+	// WorkTreePath, if set, is the file in the working tree that holds the
+	// content of the blob. The blob itself may not exist in GitRepo.
+	WorkTreePath string
 }
 
 func (l *BlobLocation) Blob(id *plumbing.Hash) ([]byte, error) {
@@ -0,0 +1,186 @@
+package gitindex
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultWorkTreeBranch is the conventional name of the virtual branch that
+// holds the working tree of a repository. See Options.WorkTreeBranch.
+const DefaultWorkTreeBranch = "WORKTREE"
+
+// workTree describes the state of a working tree relative to HEAD.
+type workTree struct {
+	// root is the directory of the working tree.
+	root string
+
+	head *object.Commit
+
+	// changed maps the slash separated paths that differ between HEAD and
+	// the working tree to the blob ID of their content in the working tree.
+	// Removed paths map to the zero hash.
+	changed map[string]plumbing.Hash
+}
+
+// readWorkTree collects the files of the working tree of repo that are
+// modified, staged or untracked. Files ignored by git are skipped.
+func readWorkTree(repo *git.Repository) (*workTree, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("indexing the working tree requires a non-bare repository: %w", err)
+	}
+
+	// go-git uses the repository directory as working tree if it isn't given
+	// one, so check that it actually is a checkout.
+	root := wt.Filesystem.Root()
+	if _, err := os.Stat(filepath.Join(root, git.GitDirName)); err != nil {
+		return nil, fmt.Errorf("indexing the working tree requires a non-bare repository, %q is not a checkout", root)
+	}
+
+	ref, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("resolving HEAD: %w", err)
+	}
+	head, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return nil, fmt.Errorf("worktree status: %w", err)
+	}
+
+	w := &workTree{
+		root:    root,
+		head:    head,
+		changed: map[string]plumbing.Hash{},
+	}
+	for path, s := range status {
+		if s.Staging == git.Unmodified && s.Worktree == git.Unmodified {
+			continue
+		}
+
+		// Only regular files have content we can index. Anything else is
+		// treated as removed from the working tree.
+		fn := filepath.Join(w.root, filepath.FromSlash(path))
+		info, err := os.Lstat(fn)
+		if err != nil || !info.Mode().IsRegular() {
+			w.changed[path] = plumbing.ZeroHash
+			continue
+		}
+
+		content, err := os.ReadFile(fn)
+		if os.IsNotExist(err) {
+			w.changed[path] = plumbing.ZeroHash
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		w.changed[path] = plumbing.ComputeHash(plumbing.BlobObject, content)
+	}
+
+	return w, nil
+}
+
+// version returns the version recorded for the working tree branch. It is the
+// commit ID of HEAD, followed by a fingerprint of the changes if the working
+// tree is dirty. The version changes whenever the content of the working tree
+// does, which keeps incremental indexing working.
+func (w *workTree) version() string {
+	if len(w.changed) == 0 {
+		return w.head.Hash.String()
+	}
+
+	paths := make([]string, 0, len(w.changed))
+	for p := range w.changed {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	h := sha1.New()
+	for _, p := range paths {
+		id := w.changed[p]
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+		h.Write(id[:])
+	}
+	return w.head.Hash.String() + "-dirty-" + hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// collectFiles adds the files of the working tree to repos, as members of
+// branch. Unchanged files share their blob with HEAD, while changed and
+// untracked files are read from disk. Submodules are not descended into.
+func (w *workTree) collectFiles(repo *git.Repository, repoURL string, branch string, repos map[fileKey]BlobLocation) (map[string]plumbing.Hash, error) {
+	tree, err := w.head.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("commit.Tree: %w", err)
+	}
+
+	ig, err := newIgnoreMatcher(tree)
+	if err != nil {
+		return nil, fmt.Errorf("newIgnoreMatcher: %w", err)
+	}
+
+	rw := NewRepoWalker(repo, repoURL, nil)
+	rw.Files = repos
+	subVersions, err := rw.CollectFiles(tree, branch, ig)
+	if err != nil {
+		return nil, fmt.Errorf("CollectFiles: %w", err)
+	}
+
+	for path, id := range w.changed {
+		// Drop the HEAD version of the file from the branch.
+		if f, err := tree.File(path); err == nil {
+			removeBranch(repos, fileKey{Path: path, ID: f.Hash}, branch)
+		}
+
+		if id.IsZero() || ig.Match(path) {
+			continue
+		}
+
+		key := fileKey{Path: path, ID: id}
+		loc, ok := repos[key]
+		if !ok {
+			loc = BlobLocation{
+				GitRepo:      repo,
+				URL:          rw.repoURL,
+				WorkTreePath: filepath.Join(w.root, filepath.FromSlash(path)),
+			}
+		}
+		loc.Branches = append(loc.Branches, branch)
+		repos[key] = loc
+	}
+
+	return subVersions, nil
+}
+
+// removeBranch removes branch from the blob at key, and drops the blob if no
+// branch contains it anymore.
+func removeBranch(repos map[fileKey]BlobLocation, key fileKey, branch string) {
+	loc, ok := repos[key]
+	if !ok {
+		return
+	}
+
+	branches := loc.Branches[:0]
+	for _, b := range loc.Branches {
+		if b != branch {
+			branches = append(branches, b)
+		}
+	}
+	if len(branches) == 0 {
+		delete(repos, key)
+		return
+	}
+	loc.Branches = branches
+	repos[key] = loc
+}
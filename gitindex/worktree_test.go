@@ -0,0 +1,206 @@
+package gitindex
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/index"
+	"github.com/sourcegraph/zoekt/query"
+	"github.com/sourcegraph/zoekt/search"
+)
+
+func TestIndexWorkTree(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+	}
+
+	writeFile(".gitignore", "*.log\n")
+	writeFile("changed.go", "committed content\n")
+	writeFile("removed.go", "removed content\n")
+	writeFile("kept.go", "kept content\n")
+	executeCommand(t, repoDir, exec.Command("git", "add", "."))
+	executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial commit"))
+
+	writeFile("changed.go", "uncommitted content\n")
+	writeFile("untracked.go", "untracked content\n")
+	writeFile("debug.log", "ignored content\n")
+	if err := os.Remove(filepath.Join(repoDir, "removed.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{
+		RepoDir:        repoDir,
+		Branches:       []string{"main"},
+		Incremental:    true,
+		WorkTreeBranch: DefaultWorkTreeBranch,
+		BuildOptions: index.Options{
+			RepositoryDescription: zoekt.Repository{Name: "repo"},
+			IndexDir:              indexDir,
+			DisableCTags:          true,
+		},
+	}
+
+	if ok, err := IndexGitRepo(opts); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected the repository to be indexed")
+	}
+
+	find := func(q query.Q) []string {
+		t.Helper()
+		searcher, err := search.NewDirectorySearcher(indexDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer searcher.Close()
+
+		res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, f := range res.Files {
+			names = append(names, f.FileName)
+		}
+		slices.Sort(names)
+		return names
+	}
+
+	tests := []struct {
+		name string
+		q    query.Q
+		want []string
+	}{{
+		name: "main",
+		q:    &query.Branch{Pattern: "main", Exact: true},
+		want: []string{".gitignore", "changed.go", "kept.go", "removed.go"},
+	}, {
+		name: "worktree",
+		q:    &query.Branch{Pattern: DefaultWorkTreeBranch, Exact: true},
+		want: []string{".gitignore", "changed.go", "kept.go", "untracked.go"},
+	}, {
+		name: "uncommitted content",
+		q:    &query.Substring{Pattern: "uncommitted", Content: true},
+		want: []string{"changed.go"},
+	}, {
+		name: "ignored content",
+		q:    &query.Substring{Pattern: "ignored", Content: true},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := find(tt.q); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The version of the working tree branch tracks its content, so
+	// incremental indexing only skips unchanged working trees.
+	if ok, err := IndexGitRepo(opts); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected unchanged working tree to be skipped")
+	}
+
+	writeFile("untracked.go", "edited content\n")
+	if ok, err := IndexGitRepo(opts); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected changed working tree to be indexed")
+	}
+	if got, want := find(&query.Substring{Pattern: "edited", Content: true}), []string{"untracked.go"}; !slices.Equal(got, want) {
+		t.Errorf("got %v after edit, want %v", got, want)
+	}
+}
+
+func TestIndexWorkTreeBare(t *testing.T) {
+	dir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+	if err := os.WriteFile(filepath.Join(repoDir, "file.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	executeCommand(t, repoDir, exec.Command("git", "add", "."))
+	executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial commit"))
+
+	opts := Options{
+		RepoDir:        filepath.Join(repoDir, ".git"),
+		Branches:       []string{"main"},
+		WorkTreeBranch: DefaultWorkTreeBranch,
+		BuildOptions: index.Options{
+			RepositoryDescription: zoekt.Repository{Name: "repo"},
+			IndexDir:              t.TempDir(),
+		},
+	}
+	if _, err := IndexGitRepo(opts); err == nil {
+		t.Fatal("expected an error indexing the working tree of a git directory")
+	}
+}
+
+func TestIndexLinkedWorkTree(t *testing.T) {
+	dir := t.TempDir()
+	indexDir := t.TempDir()
+	executeCommand(t, dir, exec.Command("git", "init", "-b", "main", "repo"))
+	repoDir := filepath.Join(dir, "repo")
+	if err := os.WriteFile(filepath.Join(repoDir, "file.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	executeCommand(t, repoDir, exec.Command("git", "add", "."))
+	executeCommand(t, repoDir, exec.Command("git", "commit", "-m", "initial commit"))
+
+	// The .git of a linked worktree is a file pointing into the git
+	// directory of repo.
+	workTreeDir := filepath.Join(dir, "linked")
+	executeCommand(t, repoDir, exec.Command("git", "worktree", "add", "-b", "linked", workTreeDir))
+	if err := os.WriteFile(filepath.Join(workTreeDir, "untracked.go"), []byte("linked content\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{
+		RepoDir:        workTreeDir,
+		Branches:       []string{"linked"},
+		WorkTreeBranch: DefaultWorkTreeBranch,
+		BuildOptions: index.Options{
+			RepositoryDescription: zoekt.Repository{Name: "linked"},
+			IndexDir:              indexDir,
+			DisableCTags:          true,
+		},
+	}
+	if ok, err := IndexGitRepo(opts); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected the linked worktree to be indexed")
+	}
+
+	searcher, err := search.NewDirectorySearcher(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Branch{Pattern: DefaultWorkTreeBranch, Exact: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range res.Files {
+		names = append(names, f.FileName)
+	}
+	slices.Sort(names)
+	if want := []string{"file.go", "untracked.go"}; !slices.Equal(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
@@ -22,6 +22,8 @@ const (
 	WebserverService_Search_FullMethodName       = "/zoekt.webserver.v1.WebserverService/Search"
 	WebserverService_StreamSearch_FullMethodName = "/zoekt.webserver.v1.WebserverService/StreamSearch"
 	WebserverService_List_FullMethodName         = "/zoekt.webserver.v1.WebserverService/List"
+	WebserverService_References_FullMethodName   = "/zoekt.webserver.v1.WebserverService/References"
+	WebserverService_Explain_FullMethodName      = "/zoekt.webserver.v1.WebserverService/Explain"
 )
 
 // WebserverServiceClient is the client API for WebserverService service.
@@ -33,6 +35,12 @@ type WebserverServiceClient interface {
 	// List lists repositories. The query `q` can only contain
 	// query.Repo atoms.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// References returns the references to a symbol from a SCIP index across
+	// all shards.
+	References(ctx context.Context, in *ReferencesRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Explain evaluates a query like Search and describes how each shard
+	// evaluated it.
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error)
 }
 
 type webserverServiceClient struct {
@@ -93,6 +101,24 @@ func (c *webserverServiceClient) List(ctx context.Context, in *ListRequest, opts
 	return out, nil
 }
 
+func (c *webserverServiceClient) References(ctx context.Context, in *ReferencesRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, WebserverService_References_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webserverServiceClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := c.cc.Invoke(ctx, WebserverService_Explain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WebserverServiceServer is the server API for WebserverService service.
 // All implementations must embed UnimplementedWebserverServiceServer
 // for forward compatibility
@@ -102,6 +128,12 @@ type WebserverServiceServer interface {
 	// List lists repositories. The query `q` can only contain
 	// query.Repo atoms.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// References returns the references to a symbol from a SCIP index across
+	// all shards.
+	References(context.Context, *ReferencesRequest) (*SearchResponse, error)
+	// Explain evaluates a query like Search and describes how each shard
+	// evaluated it.
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
 	mustEmbedUnimplementedWebserverServiceServer()
 }
 
@@ -118,6 +150,12 @@ func (UnimplementedWebserverServiceServer) StreamSearch(*StreamSearchRequest, We
 func (UnimplementedWebserverServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedWebserverServiceServer) References(context.Context, *ReferencesRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method References not implemented")
+}
+func (UnimplementedWebserverServiceServer) Explain(context.Context, *ExplainRequest) (*ExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
 func (UnimplementedWebserverServiceServer) mustEmbedUnimplementedWebserverServiceServer() {}
 
 // UnsafeWebserverServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -188,6 +226,42 @@ func _WebserverService_List_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebserverService_References_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebserverServiceServer).References(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebserverService_References_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebserverServiceServer).References(ctx, req.(*ReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebserverService_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebserverServiceServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebserverService_Explain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebserverServiceServer).Explain(ctx, req.(*ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WebserverService_ServiceDesc is the grpc.ServiceDesc for WebserverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +277,14 @@ var WebserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _WebserverService_List_Handler,
 		},
+		{
+			MethodName: "References",
+			Handler:    _WebserverService_References_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _WebserverService_Explain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,286 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignore
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// GitIgnoreFile is the name of the per-directory ignore file used by git.
+const GitIgnoreFile = ".gitignore"
+
+// GitMatcher matches paths of a working tree against the rules git uses to
+// decide whether a file is ignored: the user's global excludes file,
+// .git/info/exclude and the .gitignore file of every directory. Rules of
+// deeper directories take precedence, and a path inside an ignored directory
+// is always ignored.
+//
+// .gitignore files are read lazily the first time a path below their
+// directory is matched. A GitMatcher is safe for concurrent use.
+type GitMatcher struct {
+	root string
+
+	// gitDir and commonDir are the git directories of the working tree, see
+	// GitDir and CommonDir.
+	gitDir, commonDir string
+
+	// base holds the patterns of the global excludes file followed by
+	// .git/info/exclude, in ascending order of priority.
+	base []gitignore.Pattern
+
+	mu sync.Mutex
+	// dirs maps a slash separated directory relative to root ("" for root)
+	// to the patterns of its .gitignore file.
+	dirs map[string][]gitignore.Pattern
+}
+
+// NewGitMatcher returns a GitMatcher for the git working tree containing dir.
+// If dir is not inside a git working tree, dir itself is used as the root and
+// only .gitignore files and the global excludes file apply.
+func NewGitMatcher(dir string) (*GitMatcher, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &GitMatcher{
+		root: findWorkTree(dir),
+		dirs: map[string][]gitignore.Pattern{},
+	}
+	m.gitDir, m.commonDir = GitDirs(m.root)
+
+	if fn := globalExcludesFile(); fn != "" {
+		ps, err := readPatternsFile(fn, nil)
+		if err != nil {
+			return nil, err
+		}
+		m.base = append(m.base, ps...)
+	}
+
+	if m.commonDir != "" {
+		ps, err := readPatternsFile(filepath.Join(m.commonDir, "info", "exclude"), nil)
+		if err != nil {
+			return nil, err
+		}
+		m.base = append(m.base, ps...)
+	}
+
+	return m, nil
+}
+
+// Root returns the root of the working tree. Paths passed to Match are
+// relative to it.
+func (m *GitMatcher) Root() string {
+	return m.root
+}
+
+// GitDir returns the git directory of the working tree, which holds its HEAD
+// and index, or "" if Root is not a git working tree.
+func (m *GitMatcher) GitDir() string {
+	return m.gitDir
+}
+
+// CommonDir returns the git directory holding the refs and info/exclude of
+// the working tree. It differs from GitDir for linked worktrees.
+func (m *GitMatcher) CommonDir() string {
+	return m.commonDir
+}
+
+// Match returns true if git would ignore path, which is slash separated and
+// relative to Root.
+func (m *GitMatcher) Match(path string, isDir bool) bool {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) == 1 && (parts[0] == "" || parts[0] == ".") {
+		return false
+	}
+
+	// It is not possible to re-include a file if a parent directory of that
+	// file is excluded.
+	for i := 1; i < len(parts); i++ {
+		if m.match(parts[:i], true) {
+			return true
+		}
+	}
+	return m.match(parts, isDir)
+}
+
+// Invalidate drops the cached patterns of the .gitignore file in dir, which
+// is slash separated and relative to Root. It should be called when that file
+// changes.
+func (m *GitMatcher) Invalidate(dir string) {
+	dir = strings.Trim(dir, "/")
+	if dir == "." {
+		dir = ""
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.dirs, dir)
+}
+
+func (m *GitMatcher) match(parts []string, isDir bool) bool {
+	// Check the .gitignore of the closest directory first, since its rules
+	// take precedence over the rules of its parents.
+	for i := len(parts) - 1; i >= 0; i-- {
+		ps := m.dirPatterns(parts[:i])
+		for j := len(ps) - 1; j >= 0; j-- {
+			if r := ps[j].Match(parts, isDir); r != gitignore.NoMatch {
+				return r == gitignore.Exclude
+			}
+		}
+	}
+
+	for j := len(m.base) - 1; j >= 0; j-- {
+		if r := m.base[j].Match(parts, isDir); r != gitignore.NoMatch {
+			return r == gitignore.Exclude
+		}
+	}
+
+	return false
+}
+
+func (m *GitMatcher) dirPatterns(dir []string) []gitignore.Pattern {
+	key := path.Join(dir...)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ps, ok := m.dirs[key]
+	if !ok {
+		// A .gitignore that can't be read is treated like a missing one, as
+		// git does.
+		ps, _ = readPatternsFile(filepath.Join(m.root, filepath.FromSlash(key), GitIgnoreFile), dir)
+		m.dirs[key] = ps
+	}
+	return ps
+}
+
+// findWorkTree returns the closest directory above or at dir that contains
+// a .git entry, or dir if there is none.
+func findWorkTree(dir string) string {
+	for cur := dir; ; {
+		if _, err := os.Stat(filepath.Join(cur, ".git")); err == nil {
+			return cur
+		}
+		parent := filepath.Dir(cur)
+		if parent == cur {
+			return dir
+		}
+		cur = parent
+	}
+}
+
+// GitDirs returns the git directory of the working tree root and the common
+// git directory holding its refs and info/exclude, or "" if there is none.
+// In linked worktrees and submodules, .git is a file pointing to the actual
+// git directory, and the git directory of a linked worktree in turn points
+// to the one of the main working tree.
+func GitDirs(root string) (gitDir, commonDir string) {
+	gitDir = filepath.Join(root, ".git")
+	fi, err := os.Stat(gitDir)
+	if err != nil {
+		return "", ""
+	}
+	if !fi.IsDir() {
+		if gitDir = readGitDirLink(root, filepath.Join(root, ".git"), "gitdir: "); gitDir == "" {
+			return "", ""
+		}
+	}
+	if common := readGitDirLink(gitDir, filepath.Join(gitDir, "commondir"), ""); common != "" {
+		return gitDir, common
+	}
+	return gitDir, gitDir
+}
+
+// readGitDirLink reads the path following prefix in the file fn. Relative
+// paths are relative to dir. It returns "" if fn can't be read.
+func readGitDirLink(dir, fn, prefix string) string {
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		return ""
+	}
+	p, ok := strings.CutPrefix(strings.TrimSpace(string(content)), prefix)
+	if !ok || p == "" {
+		return ""
+	}
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(dir, p)
+	}
+	return p
+}
+
+// globalExcludesFile returns the path of the global excludes file, which is
+// core.excludesFile in ~/.gitconfig and defaults to $XDG_CONFIG_HOME/git/ignore.
+func globalExcludesFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	if f, err := os.Open(filepath.Join(home, ".gitconfig")); err == nil {
+		defer f.Close()
+		raw := config.New()
+		if err := config.NewDecoder(f).Decode(raw); err == nil {
+			if fn := raw.Section("core").Options.Get("excludesfile"); fn != "" {
+				if rest, ok := strings.CutPrefix(fn, "~/"); ok {
+					fn = filepath.Join(home, rest)
+				}
+				return fn
+			}
+		}
+	}
+
+	xdg := os.Getenv("XDG_CONFIG_HOME")
+	if xdg == "" {
+		xdg = filepath.Join(home, ".config")
+	}
+	return filepath.Join(xdg, "git", "ignore")
+}
+
+// readPatternsFile parses the gitignore file fn, whose patterns apply to the
+// directory domain. A missing file yields no patterns.
+func readPatternsFile(fn string, domain []string) ([]gitignore.Pattern, error) {
+	f, err := os.Open(fn)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseGitIgnore(f, domain)
+}
+
+// parseGitIgnore parses gitignore patterns from r. Blank lines and lines
+// starting with # are skipped.
+func parseGitIgnore(r io.Reader, domain []string) ([]gitignore.Pattern, error) {
+	var ps []gitignore.Pattern
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, lineComment) {
+			continue
+		}
+		ps = append(ps, gitignore.ParsePattern(line, domain))
+	}
+	return ps, scanner.Err()
+}
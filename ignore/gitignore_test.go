@@ -0,0 +1,169 @@
+package ignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGitMatcher(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	root := t.TempDir()
+	files := map[string]string{
+		".git/info/exclude":                  "*.local\n",
+		".gitignore":                         "# build output\nbuild/\n*.log\n!keep.log\n",
+		"src/.gitignore":                     "generated.go\n/only-here.txt\n",
+		"src/lib/.gitignore":                 "!debug.log\n",
+		"vendor/.gitignore":                  "*\n!.gitignore\n",
+		filepath.Join(home, ".gitconfig"):    "[core]\n\texcludesFile = ~/global-ignore\n",
+		filepath.Join(home, "global-ignore"): ".DS_Store\n",
+		"node_modules/pkg/index.js":          "",
+		"src/lib/only-here.txt":              "",
+		"src/lib/generated.go":               "",
+		"src/main.go":                        "",
+		"src/only-here.txt":                  "",
+		"build/out.bin":                      "",
+		"docs/build/readme.md":               "",
+		"debug.log":                          "",
+		"src/lib/debug.log":                  "",
+		"keep.log":                           "",
+		"settings.local":                     "",
+		"src/.DS_Store":                      "",
+		"vendor/dep/dep.go":                  "",
+		"vendor/readme.md":                   "",
+	}
+	for name, content := range files {
+		fn := name
+		if !filepath.IsAbs(fn) {
+			fn = filepath.Join(root, filepath.FromSlash(name))
+		}
+		if err := os.MkdirAll(filepath.Dir(fn), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fn, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m, err := NewGitMatcher(filepath.Join(root, "src"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Root() != root {
+		t.Fatalf("got root %q, want %q", m.Root(), root)
+	}
+
+	tests := []struct {
+		path      string
+		isDir     bool
+		wantMatch bool
+	}{
+		{path: "src/main.go"},
+		{path: "src/generated.go", wantMatch: true},
+		{path: "src/lib/generated.go", wantMatch: true},
+		{path: "src/only-here.txt", wantMatch: true},
+		{path: "src/lib/only-here.txt"},
+		{path: "build", isDir: true, wantMatch: true},
+		{path: "build/out.bin", wantMatch: true},
+		{path: "docs/build/readme.md", wantMatch: true},
+		{path: "debug.log", wantMatch: true},
+		{path: "keep.log"},
+		{path: "src/lib/debug.log"},
+		{path: "settings.local", wantMatch: true},
+		{path: "src/.DS_Store", wantMatch: true},
+		{path: "vendor/.gitignore"},
+		{path: "vendor/readme.md", wantMatch: true},
+		{path: "vendor/dep/dep.go", wantMatch: true},
+		{path: "node_modules/pkg/index.js"},
+		{path: "."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := m.Match(tt.path, tt.isDir); got != tt.wantMatch {
+				t.Errorf("got %t, expected %t", got, tt.wantMatch)
+			}
+		})
+	}
+
+	// Changes to a .gitignore only apply after invalidating it.
+	if err := os.WriteFile(filepath.Join(root, "src", ".gitignore"), []byte("main.go\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if m.Match("src/main.go", false) {
+		t.Fatalf("src/main.go ignored before invalidation")
+	}
+	m.Invalidate("src")
+	if !m.Match("src/main.go", false) {
+		t.Fatalf("src/main.go not ignored after invalidation")
+	}
+	if m.Match("src/generated.go", false) {
+		t.Fatalf("src/generated.go still ignored after invalidation")
+	}
+}
+
+// In linked worktrees and submodules .git is a file, and info/exclude is
+// read from the git directory it points to.
+func TestGitMatcherGitFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"main/.git/info/exclude": "*.main\n",
+		// As created by "git worktree add ../worktree".
+		"worktree/.git":                          "gitdir: " + filepath.Join(dir, "main/.git/worktrees/worktree") + "\n",
+		"main/.git/worktrees/worktree/commondir": "../..\n",
+		// As created by "git submodule add", with a relative path.
+		"main/sub/.git":                      "gitdir: ../.git/modules/sub\n",
+		"main/.git/modules/sub/info/exclude": "*.sub\n",
+	}
+	for name, content := range files {
+		fn := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fn), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fn, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		root          string
+		path          string
+		wantMatch     bool
+		wantGitDir    string
+		wantCommonDir string
+	}{
+		{root: "worktree", path: "a.main", wantMatch: true, wantGitDir: "main/.git/worktrees/worktree", wantCommonDir: "main/.git"},
+		{root: "worktree", path: "a.sub", wantGitDir: "main/.git/worktrees/worktree", wantCommonDir: "main/.git"},
+		{root: "main/sub", path: "a.sub", wantMatch: true, wantGitDir: "main/.git/modules/sub", wantCommonDir: "main/.git/modules/sub"},
+		{root: "main/sub", path: "a.main", wantGitDir: "main/.git/modules/sub", wantCommonDir: "main/.git/modules/sub"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.root+"/"+tt.path, func(t *testing.T) {
+			root := filepath.Join(dir, filepath.FromSlash(tt.root))
+			m, err := NewGitMatcher(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.Root() != root {
+				t.Fatalf("got root %q, want %q", m.Root(), root)
+			}
+			if want := filepath.Join(dir, filepath.FromSlash(tt.wantGitDir)); m.GitDir() != want {
+				t.Errorf("got git dir %q, want %q", m.GitDir(), want)
+			}
+			if want := filepath.Join(dir, filepath.FromSlash(tt.wantCommonDir)); m.CommonDir() != want {
+				t.Errorf("got common dir %q, want %q", m.CommonDir(), want)
+			}
+			if got := m.Match(tt.path, false); got != tt.wantMatch {
+				t.Errorf("got %t, expected %t", got, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package index
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// The blame of a shard is stored in two sections. The blameCommits section
+// holds the distinct commits referenced by the shard, each encoded as the
+// commit ID and author as uvarint length and bytes, followed by the date in
+// seconds as varint. The blame section holds one item per document: a
+// sequence of hunks, each encoded as the number of lines and the index of the
+// commit as uvarints. Documents without blame have an empty item, and shards
+// without blame leave both sections empty.
+
+// blameHunk is the decoded form of a BlameHunk.
+type blameHunk struct {
+	lines  uint32
+	commit uint32
+}
+
+func appendBlameCommit(buf []byte, c *zoekt.Blame) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(c.Commit)))
+	buf = append(buf, c.Commit...)
+	buf = binary.AppendUvarint(buf, uint64(len(c.Author)))
+	buf = append(buf, c.Author...)
+	buf = binary.AppendVarint(buf, c.Date.Unix())
+	return buf
+}
+
+func decodeBlameCommits(blob []byte) ([]zoekt.Blame, error) {
+	str := func() (string, error) {
+		n, sz := binary.Uvarint(blob)
+		if sz <= 0 || n > uint64(len(blob)-sz) {
+			return "", fmt.Errorf("blameCommits section: malformed string")
+		}
+		s := string(blob[sz : sz+int(n)])
+		blob = blob[sz+int(n):]
+		return s, nil
+	}
+
+	var commits []zoekt.Blame
+	for len(blob) > 0 {
+		commit, err := str()
+		if err != nil {
+			return nil, err
+		}
+		author, err := str()
+		if err != nil {
+			return nil, err
+		}
+		date, sz := binary.Varint(blob)
+		if sz <= 0 {
+			return nil, fmt.Errorf("blameCommits section: malformed varint")
+		}
+		blob = blob[sz:]
+
+		commits = append(commits, zoekt.Blame{
+			Commit: commit,
+			Author: author,
+			Date:   time.Unix(date, 0),
+		})
+	}
+	return commits, nil
+}
+
+func decodeBlameHunks(blob []byte, numCommits int) ([]blameHunk, error) {
+	var hunks []blameHunk
+	for len(blob) > 0 {
+		lines, sz := binary.Uvarint(blob)
+		if sz <= 0 {
+			return nil, fmt.Errorf("blame section: malformed uvarint")
+		}
+		blob = blob[sz:]
+
+		commit, sz := binary.Uvarint(blob)
+		if sz <= 0 {
+			return nil, fmt.Errorf("blame section: malformed uvarint")
+		}
+		blob = blob[sz:]
+		if commit >= uint64(numCommits) {
+			return nil, fmt.Errorf("blame section: commit %d out of range", commit)
+		}
+
+		hunks = append(hunks, blameHunk{lines: uint32(lines), commit: uint32(commit)})
+	}
+	return hunks, nil
+}
+
+// addBlame records the blame of the next document.
+func (b *ShardBuilder) addBlame(hunks []BlameHunk) {
+	var buf []byte
+	for _, h := range hunks {
+		idx, ok := b.blameCommitIndex[h.Commit]
+		if !ok {
+			idx = uint32(len(b.blameCommits))
+			b.blameCommitIndex[h.Commit] = idx
+			b.blameCommits = append(b.blameCommits, h.Blame)
+		}
+		buf = binary.AppendUvarint(buf, uint64(h.Lines))
+		buf = binary.AppendUvarint(buf, uint64(idx))
+	}
+	b.blame = append(b.blame, buf)
+}
+
+// hasBlame returns true if the shard holds blame information.
+func (d *indexData) hasBlame() bool {
+	return len(d.blameIndex) > 0
+}
+
+// readBlame returns the blame hunks of a document, or nil if it has none.
+func (d *indexData) readBlame(docID uint32) ([]blameHunk, error) {
+	if !d.hasBlame() {
+		return nil, nil
+	}
+
+	blob, err := d.readSectionBlob(simpleSection{
+		off: d.blameStart + uint64(d.blameIndex[docID]),
+		sz:  d.blameIndex[docID+1] - d.blameIndex[docID],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeBlameHunks(blob, len(d.blameCommits))
+}
+
+// blameHunks returns the blame of a document in the form of Document.Blame.
+func (d *indexData) blameHunks(docID uint32) ([]BlameHunk, error) {
+	hunks, err := d.readBlame(docID)
+	if err != nil || hunks == nil {
+		return nil, err
+	}
+
+	out := make([]BlameHunk, 0, len(hunks))
+	for _, h := range hunks {
+		out = append(out, BlameHunk{Lines: h.lines, Blame: d.blameCommits[h.commit]})
+	}
+	return out, nil
+}
+
+// lineBlame returns the commit that last changed the 1-based line number, or
+// nil if hunks don't cover it.
+func (d *indexData) lineBlame(hunks []blameHunk, line int) *zoekt.Blame {
+	end := 0
+	for _, h := range hunks {
+		end += int(h.lines)
+		if line <= end {
+			b := d.blameCommits[h.commit]
+			return &b
+		}
+	}
+	return nil
+}
+
+// annotateBlame sets the Blame of the line and chunk matches of fm, which
+// holds the matches of docID.
+func (d *indexData) annotateBlame(fm *zoekt.FileMatch, docID uint32) error {
+	hunks, err := d.readBlame(docID)
+	if err != nil || hunks == nil {
+		return err
+	}
+
+	for i := range fm.LineMatches {
+		lm := &fm.LineMatches[i]
+		if !lm.FileName {
+			lm.Blame = d.lineBlame(hunks, lm.LineNumber)
+		}
+	}
+
+	for i := range fm.ChunkMatches {
+		cm := &fm.ChunkMatches[i]
+		if cm.FileName {
+			continue
+		}
+		for _, r := range cm.Ranges {
+			for line := r.Start.LineNumber; line <= r.End.LineNumber; line++ {
+				if b := d.lineBlame(hunks, int(line)); b != nil && (cm.Blame == nil || b.Date.After(cm.Blame.Date)) {
+					cm.Blame = b
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newModifiedMatchTree returns a matchTree for documents whose most recent
+// change according to blame falls within the range of q.
+func (d *indexData) newModifiedMatchTree(q *query.Modified) matchTree {
+	if !d.hasBlame() {
+		return &noMatchTree{Why: "no blame"}
+	}
+
+	return &docMatchTree{
+		reason:  "modified",
+		numDocs: d.numDocs(),
+		predicate: func(docID uint32) bool {
+			hunks, err := d.readBlame(docID)
+			if err != nil || len(hunks) == 0 {
+				return false
+			}
+
+			var last time.Time
+			for _, h := range hunks {
+				if date := d.blameCommits[h.commit].Date; date.After(last) {
+					last = date
+				}
+			}
+			if !q.After.IsZero() && last.Before(q.After) {
+				return false
+			}
+			if !q.Before.IsZero() && !last.Before(q.Before) {
+				return false
+			}
+			return true
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestBlame(t *testing.T) {
+	old := zoekt.Blame{Commit: "c1", Author: "Alice <alice@example.com>", Date: time.Unix(1600000000, 0)}
+	recent := zoekt.Blame{Commit: "c2", Author: "Bob <bob@example.com>", Date: time.Unix(1700000000, 0)}
+
+	b := testShardBuilder(t, nil,
+		Document{
+			Name:    "f1",
+			Content: []byte("old line\nnew line\nold again\n"),
+			Blame:   []BlameHunk{{Lines: 1, Blame: old}, {Lines: 1, Blame: recent}, {Lines: 1, Blame: old}},
+		},
+		Document{
+			Name:    "f2",
+			Content: []byte("old line\n"),
+			Blame:   []BlameHunk{{Lines: 1, Blame: old}},
+		},
+		Document{Name: "f3", Content: []byte("no blame line\n")},
+	)
+
+	t.Run("LineMatches", func(t *testing.T) {
+		res := searchForTest(t, b, &query.Substring{Pattern: "line", Content: true}, zoekt.SearchOptions{Blame: true})
+		got := map[string][]string{}
+		for _, f := range res.Files {
+			for _, lm := range f.LineMatches {
+				commit := ""
+				if lm.Blame != nil {
+					commit = lm.Blame.Commit
+				}
+				got[f.FileName] = append(got[f.FileName], commit)
+			}
+		}
+		want := map[string][]string{"f1": {"c1", "c2"}, "f2": {"c1"}, "f3": {""}}
+		if d := cmp.Diff(want, got); d != "" {
+			t.Errorf("mismatch (-want +got):\n%s", d)
+		}
+	})
+
+	t.Run("ChunkMatches", func(t *testing.T) {
+		res := searchForTest(t, b, &query.Regexp{Regexp: mustParseRE("old.*\\n.*line"), Content: true}, zoekt.SearchOptions{Blame: true, ChunkMatches: true})
+		if len(res.Files) != 1 || len(res.Files[0].ChunkMatches) != 1 {
+			t.Fatalf("got %+v, want a single chunk", res.Files)
+		}
+		if got := res.Files[0].ChunkMatches[0].Blame; got == nil || *got != recent {
+			t.Errorf("got %v, want the most recent commit %v", got, recent)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		res := searchForTest(t, b, &query.Substring{Pattern: "new", Content: true})
+		if len(res.Files) != 1 || res.Files[0].LineMatches[0].Blame != nil {
+			t.Errorf("got %+v, want no blame", res.Files)
+		}
+	})
+
+	t.Run("Modified", func(t *testing.T) {
+		for _, tc := range []struct {
+			q    *query.Modified
+			want []string
+		}{
+			{&query.Modified{After: time.Unix(1650000000, 0)}, []string{"f1"}},
+			{&query.Modified{Before: time.Unix(1650000000, 0)}, []string{"f2"}},
+			{&query.Modified{After: time.Unix(1500000000, 0)}, []string{"f1", "f2"}},
+		} {
+			res := searchForTest(t, b, tc.q)
+			var got []string
+			for _, f := range res.Files {
+				got = append(got, f.FileName)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+			}
+		}
+	})
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		d := searcherForTest(t, b).(*indexData)
+		got, err := d.blameHunks(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []BlameHunk{{Lines: 1, Blame: old}, {Lines: 1, Blame: recent}, {Lines: 1, Blame: old}}
+		if d := cmp.Diff(want, got); d != "" {
+			t.Errorf("mismatch (-want +got):\n%s", d)
+		}
+		if got, err := d.blameHunks(2); err != nil || got != nil {
+			t.Errorf("got %v, %v for document without blame", got, err)
+		}
+	})
+}
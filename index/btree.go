@@ -294,6 +294,9 @@ type btreeIndex struct {
 	ngramSec simpleSection
 
 	postingIndex simpleSection
+
+	// postingBase is added to the offsets in postingIndex.
+	postingBase uint64
 }
 
 // SizeBytes returns how much memory this structure uses in the heap.
@@ -303,8 +306,10 @@ func (b btreeIndex) SizeBytes() (sz int) {
 		sz += int(pointerSize) + b.bt.sizeBytes()
 	}
 	// ngramSec
-	sz += 8
+	sz += 16
 	// postingIndex
+	sz += 16
+	// postingBase
 	sz += 8
 	// postingDataSentinelOffset
 	sz += 4
@@ -317,9 +322,12 @@ func (b btreeIndex) SizeBytes() (sz int) {
 // 2. Read the bucket from disk (1 disk access)
 // 3. Binary search the bucket (in MEM)
 // 4. Return the simple section pointing to the posting list (in MEM)
-func (b btreeIndex) Get(ng ngram) (ss simpleSection) {
+//
+// It returns an empty section if the ngram isn't in the index, and an error
+// if the index couldn't be read.
+func (b btreeIndex) Get(ng ngram) (simpleSection, error) {
 	if b.bt == nil {
-		return simpleSection{}
+		return simpleSection{}, nil
 	}
 
 	// find bucket
@@ -329,7 +337,7 @@ func (b btreeIndex) Get(ng ngram) (ss simpleSection) {
 	off, sz := b.getBucket(bucketIndex)
 	bucket, err := b.file.Read(off, sz)
 	if err != nil {
-		return simpleSection{}
+		return simpleSection{}, err
 	}
 
 	// find ngram in bucket
@@ -345,7 +353,7 @@ func (b btreeIndex) Get(ng ngram) (ss simpleSection) {
 
 	// return associated posting list
 	if x >= bucketSize || getNGram(x) != ng {
-		return simpleSection{}
+		return simpleSection{}, nil
 	}
 
 	return b.getPostingList(postingIndexOffset + x)
@@ -356,31 +364,31 @@ func (b btreeIndex) Get(ng ngram) (ss simpleSection) {
 //
 // Assumming we don't hit a page boundary, which should be rare given that we
 // only read 8 bytes, we need 1 disk access to read the posting offset.
-func (b btreeIndex) getPostingList(ngramIndex int) simpleSection {
+func (b btreeIndex) getPostingList(ngramIndex int) (simpleSection, error) {
 	relativeOffsetBytes := uint32(ngramIndex) * 4
 
 	if relativeOffsetBytes+8 <= b.postingIndex.sz {
 		// read 2 offsets
-		o, err := b.file.Read(b.postingIndex.off+relativeOffsetBytes, 8)
+		o, err := b.file.Read(b.postingIndex.off+uint64(relativeOffsetBytes), 8)
 		if err != nil {
-			return simpleSection{}
+			return simpleSection{}, err
 		}
 
 		start := binary.BigEndian.Uint32(o[0:4])
 		end := binary.BigEndian.Uint32(o[4:8])
 		return simpleSection{
-			off: start,
+			off: b.postingBase + uint64(start),
 			sz:  end - start,
-		}
+		}, nil
 	} else {
 		// last ngram => read 1 offset and calculate the size of the posting
 		// list from the offset of index section.
-		o, err := b.file.Read(b.postingIndex.off+relativeOffsetBytes, 4)
+		o, err := b.file.Read(b.postingIndex.off+uint64(relativeOffsetBytes), 4)
 		if err != nil {
-			return simpleSection{}
+			return simpleSection{}, err
 		}
 
-		start := binary.BigEndian.Uint32(o[0:4])
+		start := b.postingBase + uint64(binary.BigEndian.Uint32(o[0:4]))
 		return simpleSection{
 			off: start,
 			// The layout of the posting list compound section on disk is
@@ -391,19 +399,19 @@ func (b btreeIndex) getPostingList(ngramIndex int) simpleSection {
 			//                      <---------->
 			//                    last posting list
 			//
-			sz: b.postingIndex.off - start,
-		}
+			sz: uint32(b.postingIndex.off - start),
+		}, nil
 	}
 }
 
-func (b btreeIndex) getBucket(bucketIndex int) (off uint32, sz uint32) {
+func (b btreeIndex) getBucket(bucketIndex int) (off uint64, sz uint32) {
 	// All but the rightmost bucket have exactly bucketSize/2 ngrams
 	sz = uint32(b.bt.opts.bucketSize / 2 * ngramEncoding)
-	off = b.ngramSec.off + uint32(bucketIndex)*sz
+	off = b.ngramSec.off + uint64(bucketIndex)*uint64(sz)
 
 	// Rightmost bucket has size upto the end of the ngramSec.
 	if bucketIndex == b.bt.lastBucketIndex {
-		sz = b.ngramSec.off + b.ngramSec.sz - off
+		sz = uint32(b.ngramSec.off + uint64(b.ngramSec.sz) - off)
 	}
 
 	return
@@ -429,7 +437,7 @@ func (b btreeIndex) DumpMap() map[ngram]simpleSection {
 			// decode all ngrams in the bucket and fill map
 			for i := range len(bucket) / ngramEncoding {
 				gram := ngram(binary.BigEndian.Uint64(bucket[i*8:]))
-				m[gram] = b.getPostingList(int(n.postingIndexOffset) + i)
+				m[gram], _ = b.getPostingList(int(n.postingIndexOffset) + i)
 			}
 		case *innerNode:
 			return
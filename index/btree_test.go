@@ -65,13 +65,13 @@ func TestFindBucket(t *testing.T) {
 }
 
 func TestGetBucket(t *testing.T) {
-	var off uint32 = 13
+	var off uint64 = 13
 	bucketSize := 4
 
 	cases := []struct {
 		nNgrams     int
 		bucketIndex int
-		wantOff     uint32
+		wantOff     uint64
 		wantSz      uint32
 	}{
 		// tiny B-tree with just 1 bucket.
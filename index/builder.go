@@ -91,12 +91,22 @@ type Options struct {
 	// If set, ctags must succeed.
 	CTagsMustSucceed bool
 
+	// SCIPIndex is the path to a SCIP index of the repository. If set, the
+	// definitions, references and hover documentation it records are
+	// stored with the documents whose path matches.
+	SCIPIndex string
+
 	// LargeFiles is a slice of glob patterns, including ** for any number
 	// of directories, where matching file paths should be indexed
 	// regardless of their size. The full pattern syntax is here:
 	// https://github.com/bmatcuk/doublestar/tree/v1#patterns.
 	LargeFiles []string
 
+	// SourceOptions are options of the document source, such as gitindex,
+	// that change which data is indexed. They are part of GetHash, so an
+	// incremental build rebuilds the index when they change.
+	SourceOptions []string
+
 	// IsDelta is true if this run contains only the changed documents since the
 	// last run.
 	IsDelta bool
@@ -112,6 +122,12 @@ type Options struct {
 	// Sourcegraph specific option.
 	ShardMerging bool
 
+	// CompressContent stores the file contents in independently compressed
+	// blocks, which makes shards smaller at the cost of decompressing
+	// content when it is read. Such shards need a reader with
+	// FeatureVersion 13 or later.
+	CompressContent bool
+
 	// HeapProfileTriggerBytes is the heap allocation in bytes that will trigger a memory profile. If 0, no memory profile
 	// will be triggered. Note this trigger looks at total heap allocation (which includes both inuse and garbage objects).
 	//
@@ -128,6 +144,8 @@ type HashOptions struct {
 	ctagsPath        string
 	cTagsMustSucceed bool
 	largeFiles       []string
+	sourceOptions    []string
+	scipIndex        string
 }
 
 func (o *Options) HashOptions() HashOptions {
@@ -137,6 +155,8 @@ func (o *Options) HashOptions() HashOptions {
 		ctagsPath:        o.CTagsPath,
 		cTagsMustSucceed: o.CTagsMustSucceed,
 		largeFiles:       o.LargeFiles,
+		sourceOptions:    o.SourceOptions,
+		scipIndex:        o.SCIPIndex,
 	}
 }
 
@@ -150,6 +170,21 @@ func (o *Options) GetHash() string {
 	hasher.Write(fmt.Appendf(nil, "%q", h.largeFiles))
 	hasher.Write(fmt.Appendf(nil, "%t", h.disableCTags))
 
+	// Only hash source options if set, so that indexes built without them
+	// keep their hash.
+	if len(h.sourceOptions) > 0 {
+		hasher.Write(fmt.Appendf(nil, "%q", h.sourceOptions))
+	}
+
+	// A SCIP index is regenerated in place, so hash its modification time and
+	// size too. If it cannot be read, building the index fails anyway.
+	if h.scipIndex != "" {
+		hasher.Write([]byte(h.scipIndex))
+		if fi, err := os.Stat(h.scipIndex); err == nil {
+			hasher.Write(fmt.Appendf(nil, "%d %d", fi.ModTime().UnixNano(), fi.Size()))
+		}
+	}
+
 	return fmt.Sprintf("%x", hasher.Sum(nil))
 }
 
@@ -182,6 +217,8 @@ func (o *Options) Flags(fs *flag.FlagSet) {
 	fs.IntVar(&o.Parallelism, "parallelism", x.Parallelism, "maximum number of parallel indexing processes.")
 	fs.StringVar(&o.IndexDir, "index", x.IndexDir, "directory for search indices")
 	fs.BoolVar(&o.CTagsMustSucceed, "require_ctags", x.CTagsMustSucceed, "If set, ctags calls must succeed.")
+	fs.StringVar(&o.SCIPIndex, "scip", x.SCIPIndex, "path to a SCIP index of the repository, for precise def: and ref: queries.")
+	fs.BoolVar(&o.CompressContent, "compress_content", x.CompressContent, "If set, file contents are stored in compressed blocks.")
 	fs.Var(largeFilesFlag{o}, "large_file", "A glob pattern where matching files are to be index regardless of their size. You can add multiple patterns by setting this more than once.")
 
 	// Sourcegraph specific
@@ -217,6 +254,14 @@ func (o *Options) Args() []string {
 		args = append(args, "-require_ctags")
 	}
 
+	if o.SCIPIndex != "" {
+		args = append(args, "-scip", o.SCIPIndex)
+	}
+
+	if o.CompressContent {
+		args = append(args, "-compress_content")
+	}
+
 	for _, a := range o.LargeFiles {
 		args = append(args, "-large_file", a)
 	}
@@ -246,7 +291,11 @@ type Builder struct {
 	size         int
 
 	parserBins ctags.ParserBinMap
-	building   sync.WaitGroup
+
+	// scip holds the occurrences from Options.SCIPIndex, if set.
+	scip *scipOccurrences
+
+	building sync.WaitGroup
 
 	errMu      sync.Mutex
 	buildError error
@@ -548,6 +597,10 @@ func NewBuilder(opts Options) (*Builder, error) {
 		return nil, fmt.Errorf("builder: must set Name")
 	}
 
+	if err := opts.recoverCompaction(); err != nil {
+		return nil, fmt.Errorf("builder: %w", err)
+	}
+
 	b := &Builder{
 		opts:           opts,
 		throttle:       make(chan int, opts.Parallelism),
@@ -566,6 +619,12 @@ func NewBuilder(opts Options) (*Builder, error) {
 
 	b.parserBins = parserBins
 
+	if opts.SCIPIndex != "" {
+		if b.scip, err = loadSCIPOccurrences(opts.SCIPIndex); err != nil {
+			return nil, err
+		}
+	}
+
 	if opts.IsDelta {
 		// Delta shards build on top of previously existing shards.
 		// As a consequence, the shardNum for delta shards starts from
@@ -609,6 +668,10 @@ func (b *Builder) Add(doc Document) error {
 		doc.SkipReason = skip
 	}
 
+	if b.scip != nil && doc.SkipReason == SkipReasonNone && doc.Occurrences == nil {
+		doc.Occurrences = b.scip.occurrences(doc.Name, doc.Content)
+	}
+
 	b.todo = append(b.todo, &doc)
 
 	if doc.SkipReason == SkipReasonNone {
@@ -867,6 +930,11 @@ type rankedDoc struct {
 // at query time, because earlier documents receive a boost at query time and
 // have a higher chance of being searched before limits kick in.
 func rank(d *Document, origIdx int) []float64 {
+	// Commits are placed after all files, in the order they were added.
+	if d.Commit != nil {
+		return []float64{2, 0, 0, 0, 0, 0, 0, 0, squashRange(origIdx)}
+	}
+
 	skipped := 0.0
 	if d.SkipReason != SkipReasonNone {
 		skipped = 1.0
@@ -1019,6 +1087,7 @@ func (b *Builder) newShardBuilder() (*ShardBuilder, error) {
 	}
 	shardBuilder.IndexTime = b.indexTime
 	shardBuilder.ID = b.id
+	shardBuilder.compressContent = b.opts.CompressContent
 	return shardBuilder, nil
 }
 
@@ -134,6 +134,11 @@ func TestFlags(t *testing.T) {
 		want: Options{
 			LargeFiles: []string{"*.md", "\\!*.yaml"},
 		},
+	}, {
+		args: []string{"-compress_content"},
+		want: Options{
+			CompressContent: true,
+		},
 	}}
 
 	ignored := []cmp.Option{
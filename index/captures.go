@@ -0,0 +1,75 @@
+package index
+
+import (
+	"bytes"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// captureValues returns the values of the first capture group of re in
+// data, or of the whole matches if re has no groups. Matches where the group
+// doesn't participate are skipped.
+func captureValues(re *regexp.Regexp, data []byte) []string {
+	group := 0
+	if re.NumSubexp() > 0 {
+		group = 1
+	}
+
+	var values []string
+	for _, m := range re.FindAllSubmatchIndex(data, -1) {
+		start, end := m[2*group], m[2*group+1]
+		if start < 0 {
+			continue
+		}
+		values = append(values, string(data[start:end]))
+	}
+	return values
+}
+
+// captureMatchedLines returns the capture values of re, as returned by
+// captureValues, in the lines of data holding the content matches among
+// cands, which are sorted and don't overlap.
+func captureMatchedLines(re *regexp.Regexp, data []byte, cands []*candidateMatch) []string {
+	var (
+		values     []string
+		start, end = -1, -1
+	)
+	flush := func() {
+		if start >= 0 {
+			values = append(values, captureValues(re, data[start:end])...)
+		}
+	}
+	for _, m := range cands {
+		if m.fileName {
+			continue
+		}
+		matchEnd := int(m.byteOffset + m.byteMatchSz)
+		lineStart := bytes.LastIndexByte(data[:m.byteOffset], '\n') + 1
+		lineEnd := len(data)
+		if i := bytes.IndexByte(data[matchEnd:], '\n'); i >= 0 {
+			lineEnd = matchEnd + i
+		}
+
+		// Matches on the same lines share the capture values of these lines.
+		if lineStart <= end {
+			end = max(end, lineEnd)
+			continue
+		}
+		flush()
+		start, end = lineStart, lineEnd
+	}
+	flush()
+	return values
+}
+
+// countCaptures counts the captures of fm in res.
+func countCaptures(res *zoekt.SearchResult, fm *zoekt.FileMatch) {
+	if res.Captures == nil {
+		res.Captures = map[string]int{}
+	}
+	for _, v := range fm.Captures {
+		res.Captures[v]++
+	}
+}
@@ -0,0 +1,95 @@
+package index
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestCaptureValues(t *testing.T) {
+	data := []byte("import \"github.com/a/b/c\"\nimport \"github.com/d/e\"\nimport \"fmt\"\n")
+	for _, tc := range []struct {
+		re   string
+		want []string
+	}{
+		{`import "(github\.com/[^/]+/[^/"]+)`, []string{"github.com/a/b", "github.com/d/e"}},
+		// Without groups the whole match is the value.
+		{`github\.com/[^/]+`, []string{"github.com/a", "github.com/d"}},
+		// Matches where the group doesn't participate are skipped.
+		{`"(fmt)?`, []string{"fmt"}},
+		{`x(y)`, nil},
+	} {
+		got := captureValues(regexp.MustCompile(tc.re), data)
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.re, d)
+		}
+	}
+}
+
+func TestCaptureMatchedLines(t *testing.T) {
+	data := []byte("a x1\nb x2\nc x3 x4\nd x5\n")
+	re := regexp.MustCompile(`x\d`)
+	match := func(s string) *candidateMatch {
+		i := strings.Index(string(data), s)
+		return &candidateMatch{byteOffset: uint32(i), byteMatchSz: uint32(len(s))}
+	}
+	for _, tc := range []struct {
+		cands []*candidateMatch
+		want  []string
+	}{
+		{[]*candidateMatch{match("a")}, []string{"x1"}},
+		// Two matches on a line capture its values once.
+		{[]*candidateMatch{match("c"), match("x4")}, []string{"x3", "x4"}},
+		// A match spanning lines captures all of them.
+		{[]*candidateMatch{match("x1\nb")}, []string{"x1", "x2"}},
+		{[]*candidateMatch{match("a"), match("d")}, []string{"x1", "x5"}},
+		// File name matches capture nothing.
+		{[]*candidateMatch{{fileName: true, byteMatchSz: 1}}, nil},
+	} {
+		got := captureMatchedLines(re, data, tc.cands)
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("mismatch (-want +got):\n%s", d)
+		}
+	}
+}
+
+func TestCaptureSearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		// The unmatched comment isn't captured.
+		Document{Name: "a.go", Content: []byte("import \"github.com/a/b\"\n// see \"github.com/x/y\"\nimport \"github.com/c/d\"\n")},
+		Document{Name: "b.go", Content: []byte("import \"github.com/a/b\"\n")},
+		Document{Name: "c.go", Content: []byte("import \"fmt\"\n")},
+	)
+	searcher := searcherForTest(t, b)
+	q := &query.Substring{Pattern: "import", Content: true}
+
+	res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{CaptureRegexp: `"(github\.com/[^"]+)"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string][]string{}
+	for _, f := range res.Files {
+		got[f.FileName] = f.Captures
+	}
+	want := map[string][]string{
+		"a.go": {"github.com/a/b", "github.com/c/d"},
+		"b.go": {"github.com/a/b"},
+		"c.go": nil,
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("FileMatch.Captures mismatch (-want +got):\n%s", d)
+	}
+	if d := cmp.Diff(map[string]int{"github.com/a/b": 2, "github.com/c/d": 1}, res.Captures); d != "" {
+		t.Errorf("SearchResult.Captures mismatch (-want +got):\n%s", d)
+	}
+
+	if _, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{CaptureRegexp: "("}); err == nil {
+		t.Error("got no error for invalid CaptureRegexp")
+	}
+}
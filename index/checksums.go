@@ -0,0 +1,177 @@
+package index
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+)
+
+// The sectionChecksums section holds the CRC-32C checksums of the other
+// sections. For each tagged section, it holds the tag, the number of
+// checksums, and the checksums of its simple sections as big-endian uint32s:
+// one for simple sections and one each for the data and the index of
+// compound sections. It is written after all other sections, but before the
+// TOC. Shards written before the section was added leave it empty.
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrCorrupt is returned for shards that fail verification.
+var ErrCorrupt = errors.New("corrupt shard")
+
+// QuarantineDir is the directory, relative to the index directory, that
+// zoekt-verify moves corrupt shards to.
+const QuarantineDir = ".quarantine"
+
+// verifyChecksumsOnLoad is set if NewSearcher verifies the checksums of the
+// sections of a shard before loading it.
+var verifyChecksumsOnLoad = os.Getenv("ZOEKT_VERIFY_CHECKSUMS") != ""
+
+// sectionParts returns the simple sections that make up s.
+func sectionParts(s section) []simpleSection {
+	switch s := s.(type) {
+	case *simpleSection:
+		return []simpleSection{*s}
+	case *compoundSection:
+		return []simpleSection{s.data, s.index}
+	case *lazyCompoundSection:
+		return []simpleSection{s.data, s.index}
+	}
+	return nil
+}
+
+// encodeSectionChecksums returns the content of the sectionChecksums
+// section for the sections of toc written to w.
+func encodeSectionChecksums(toc *indexTOC, w *writer) []byte {
+	var buf bytes.Buffer
+	out := &writer{w: &buf}
+	for _, ts := range toc.sectionsTaggedList() {
+		if ts.sec == &toc.sectionChecksums {
+			continue
+		}
+		parts := sectionParts(ts.sec)
+		sums := make([]uint32, 0, len(parts))
+		for _, p := range parts {
+			sum, ok := w.checksums[p]
+			if !ok {
+				break
+			}
+			sums = append(sums, sum)
+		}
+		if len(sums) != len(parts) {
+			continue
+		}
+
+		out.String(ts.tag)
+		out.Varint(uint32(len(sums)))
+		for _, sum := range sums {
+			out.U32(sum)
+		}
+	}
+	return buf.Bytes()
+}
+
+// verifyChecksums checks the sections of toc against their checksums. It
+// returns nil if the shard has no checksums.
+func (r *reader) verifyChecksums(toc *indexTOC) error {
+	if toc.sectionChecksums.sz == 0 {
+		return nil
+	}
+
+	blob, err := r.r.Read(toc.sectionChecksums.off, toc.sectionChecksums.sz)
+	if err != nil {
+		return err
+	}
+
+	secs := toc.sectionsTagged()
+	for len(blob) > 0 {
+		tagLen, n := binary.Uvarint(blob)
+		if n <= 0 || tagLen > uint64(len(blob)-n) {
+			return fmt.Errorf("%w: malformed checksums", ErrCorrupt)
+		}
+		tag := string(blob[n : n+int(tagLen)])
+		blob = blob[n+int(tagLen):]
+
+		count, n := binary.Uvarint(blob)
+		if n <= 0 || count > uint64(len(blob)-n)/4 {
+			return fmt.Errorf("%w: malformed checksums for section %s", ErrCorrupt, tag)
+		}
+		blob = blob[n:]
+		want := make([]uint32, count)
+		for i := range want {
+			want[i] = binary.BigEndian.Uint32(blob)
+			blob = blob[4:]
+		}
+
+		sec, ok := secs[tag]
+		if !ok {
+			// Written by a newer version.
+			continue
+		}
+		parts := sectionParts(sec)
+		if len(parts) != len(want) {
+			return fmt.Errorf("%w: section %s: got %d checksums, want %d", ErrCorrupt, tag, len(want), len(parts))
+		}
+		for i, p := range parts {
+			data, err := r.r.Read(p.off, p.sz)
+			if err != nil {
+				return fmt.Errorf("%w: section %s: %v", ErrCorrupt, tag, err)
+			}
+			if got := crc32.Checksum(data, castagnoliTable); got != want[i] {
+				return fmt.Errorf("%w: section %s: got checksum %08x, want %08x", ErrCorrupt, tag, got, want[i])
+			}
+		}
+	}
+	return nil
+}
+
+// VerifyIndexFile checks that the shard in f is intact. It verifies the
+// checksums of the sections, if the shard has them, and loads the shard.
+// Corruption is reported as an error wrapping ErrCorrupt, unlike shards
+// written in a version this reader can't read. The IndexFile is not closed.
+func VerifyIndexFile(f IndexFile) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: panic: %v", ErrCorrupt, r)
+		}
+	}()
+
+	rd := &reader{r: f}
+	var toc indexTOC
+	if err := rd.readTOC(&toc); err != nil {
+		return fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	if err := rd.verifyChecksums(&toc); err != nil {
+		return err
+	}
+	if _, err := rd.readIndexData(&toc); errors.Is(err, errUnsupportedVersion) {
+		return err
+	} else if err != nil {
+		return fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	return nil
+}
+
+// Quarantine moves the shard at path and its .meta file to the
+// QuarantineDir of its index directory. It returns the new path of the
+// shard.
+func Quarantine(path string) (string, error) {
+	dir := filepath.Join(filepath.Dir(path), QuarantineDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	paths, err := IndexFilePaths(path)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range paths {
+		if err := os.Rename(p, filepath.Join(dir, filepath.Base(p))); err != nil {
+			return "", err
+		}
+	}
+	return filepath.Join(dir, filepath.Base(path)), nil
+}
@@ -0,0 +1,121 @@
+package index
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+func checksummedShard(t *testing.T) ([]byte, *indexTOC) {
+	t.Helper()
+	b := testShardBuilder(t, &zoekt.Repository{Name: "repo"},
+		Document{Name: "f1", Content: []byte("hello world\n")},
+		Document{Name: "f2", Content: []byte("needle in a haystack\n")})
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var toc indexTOC
+	if err := (&reader{r: &memSeeker{buf.Bytes()}}).readTOC(&toc); err != nil {
+		t.Fatal(err)
+	}
+	if toc.sectionChecksums.sz == 0 {
+		t.Fatal("got no checksums")
+	}
+	return buf.Bytes(), &toc
+}
+
+func TestVerifyIndexFile(t *testing.T) {
+	data, toc := checksummedShard(t)
+	if err := VerifyIndexFile(&memSeeker{data}); err != nil {
+		t.Fatalf("intact shard: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		corrupt func([]byte) []byte
+		wantErr string
+	}{
+		{
+			name: "content",
+			corrupt: func(d []byte) []byte {
+				d[toc.fileContents.data.off] ^= 0xff
+				return d
+			},
+			wantErr: "section fileContents",
+		},
+		{
+			name: "names index",
+			corrupt: func(d []byte) []byte {
+				d[toc.fileNames.index.off+4] ^= 0xff
+				return d
+			},
+			wantErr: "section fileNames",
+		},
+		{
+			name: "truncated",
+			corrupt: func(d []byte) []byte {
+				return d[:len(d)/2]
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.corrupt(bytes.Clone(data))
+			err := VerifyIndexFile(&memSeeker{d})
+			if !errors.Is(err, ErrCorrupt) {
+				t.Fatalf("got %v, want ErrCorrupt", err)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("got %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadIndexDataVerify(t *testing.T) {
+	data, toc := checksummedShard(t)
+	data[toc.fileContents.data.off] ^= 0xff
+
+	// Without verification, the flipped byte goes unnoticed.
+	r := &reader{r: &memSeeker{data}}
+	if _, err := r.readIndexData(toc); err != nil {
+		t.Fatal(err)
+	}
+
+	r.verify = true
+	if _, err := r.readIndexData(toc); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("got %v, want ErrCorrupt", err)
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	dir := t.TempDir()
+	shard := filepath.Join(dir, "repo_v16.00000.zoekt")
+	for _, fn := range []string{shard, shard + ".meta"} {
+		if err := os.WriteFile(fn, []byte("x"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := Quarantine(shard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, QuarantineDir, "repo_v16.00000.zoekt"); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	for _, fn := range []string{got, got + ".meta"} {
+		if _, err := os.Stat(fn); err != nil {
+			t.Error(err)
+		}
+	}
+	if left, _ := filepath.Glob(filepath.Join(dir, "*")); len(left) != 1 {
+		t.Errorf("got %v left in the index directory, want only the quarantine directory", left)
+	}
+}
@@ -0,0 +1,244 @@
+package index
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"slices"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/internal/tenant"
+)
+
+// Clones are found by comparing MinHash signatures of documents. The tokens
+// of a document are its runs of letters, digits and underscores and its
+// other non-space characters, so that changes to whitespace and formatting
+// don't matter. For each of minHashSize hash functions, the signature holds
+// the smallest hash of the shingles of shingleSize consecutive tokens. The
+// fraction of equal values in the signatures of two documents estimates the
+// Jaccard similarity of their sets of shingles.
+//
+// The fingerprints section holds the signatures of all documents, each as
+// minHashSize big-endian uint32s. Documents without shingles, like skipped
+// documents, have emptySignature. Shards written before the section was
+// added leave it empty.
+
+const (
+	minHashSize = 32
+	shingleSize = 5
+
+	signatureBytes = 4 * minHashSize
+)
+
+// DefaultMinCloneSimilarity is the similarity used if
+// CloneOptions.MinSimilarity is 0.
+const DefaultMinCloneSimilarity = 0.5
+
+// minHashSeeds are the seeds of the hash functions of the signature.
+var minHashSeeds = func() (seeds [minHashSize]uint64) {
+	for i := range seeds {
+		seeds[i] = mix64(uint64(i) + 1)
+	}
+	return seeds
+}()
+
+// mix64 is the finalizer of splitmix64.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// signature is the MinHash signature of a document.
+type signature [minHashSize]uint32
+
+var emptySignature = func() (s signature) {
+	for i := range s {
+		s[i] = ^uint32(0)
+	}
+	return s
+}()
+
+// computeSignature returns the signature of content.
+func computeSignature(content []byte) signature {
+	sig := emptySignature
+
+	var window [shingleSize]uint64
+	n := 0
+	forEachToken(content, func(tok []byte) {
+		// FNV-1a
+		h := uint64(14695981039346656037)
+		for _, c := range tok {
+			h ^= uint64(c)
+			h *= 1099511628211
+		}
+		window[n%shingleSize] = h
+		n++
+		if n < shingleSize {
+			return
+		}
+
+		// Combine the tokens of the shingle in order.
+		var shingle uint64
+		for i := range shingleSize {
+			shingle = mix64(shingle ^ window[(n+i)%shingleSize])
+		}
+		for i, seed := range minHashSeeds {
+			sig[i] = min(sig[i], uint32(mix64(shingle^seed)>>32))
+		}
+	})
+	return sig
+}
+
+func isTokenRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// forEachToken calls f with the tokens of content used for shingles.
+func forEachToken(content []byte, f func(tok []byte)) {
+	for i := 0; i < len(content); {
+		r, sz := utf8.DecodeRune(content[i:])
+		if unicode.IsSpace(r) {
+			i += sz
+			continue
+		}
+		j := i + sz
+		if isTokenRune(r) {
+			for j < len(content) {
+				r, sz := utf8.DecodeRune(content[j:])
+				if !isTokenRune(r) {
+					break
+				}
+				j += sz
+			}
+		}
+		f(content[i:j])
+		i = j
+	}
+}
+
+// similarity estimates the similarity of the documents with signatures a
+// and b.
+func (a *signature) similarity(b *signature) float64 {
+	equal := 0
+	for i := range a {
+		if a[i] == b[i] {
+			equal++
+		}
+	}
+	return float64(equal) / minHashSize
+}
+
+func appendSignature(buf []byte, s *signature) []byte {
+	for _, v := range s {
+		buf = binary.BigEndian.AppendUint32(buf, v)
+	}
+	return buf
+}
+
+// addFingerprint records the signature of the next document.
+func (b *ShardBuilder) addFingerprint(doc *Document) {
+	sig := emptySignature
+	if doc.SkipReason == SkipReasonNone {
+		sig = computeSignature(doc.Content)
+	}
+	b.fingerprints = appendSignature(b.fingerprints, &sig)
+}
+
+// hasFingerprints returns true if the shard holds signatures.
+func (d *indexData) hasFingerprints() bool {
+	return len(d.fingerprints) > 0
+}
+
+func (d *indexData) signature(docID uint32) (s signature) {
+	blob := d.fingerprints[docID*signatureBytes:]
+	for i := range s {
+		s[i] = binary.BigEndian.Uint32(blob[4*i:])
+	}
+	return s
+}
+
+// Clones returns the documents of the shard that are near duplicates of
+// content.
+func (d *indexData) Clones(ctx context.Context, content []byte, opts *zoekt.CloneOptions) (*zoekt.CloneResult, error) {
+	start := time.Now()
+	res := &zoekt.CloneResult{}
+	if !d.hasFingerprints() {
+		res.Stats.ShardsSkipped++
+		return res, nil
+	}
+	res.Stats.ShardsScanned++
+
+	minSimilarity := DefaultMinCloneSimilarity
+	if opts != nil && opts.MinSimilarity > 0 {
+		minSimilarity = opts.MinSimilarity
+	}
+
+	sig := computeSignature(content)
+	if sig == emptySignature {
+		return res, nil
+	}
+
+	docCount := uint32(len(d.fileBranchMasks))
+	for docID := range docCount {
+		if docID%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		md := &d.repoMetaData[d.repos[docID]]
+		if md.Tombstone || !tenant.HasAccess(ctx, md.TenantID) {
+			continue
+		}
+		name := d.fileName(docID)
+		if _, tombstoned := md.FileTombstones[string(name)]; tombstoned {
+			continue
+		}
+
+		res.Stats.FilesConsidered++
+		docSig := d.signature(docID)
+		if docSig == emptySignature {
+			continue
+		}
+		similarity := sig.similarity(&docSig)
+		if similarity < minSimilarity {
+			continue
+		}
+		res.Clones = append(res.Clones, zoekt.Clone{
+			Repository: md.Name,
+			FileName:   string(name),
+			Branches:   d.branchNamesForMask(docID, d.fileBranchMasks[docID]),
+			Language:   d.languageMap[d.getLanguage(docID)],
+			Similarity: similarity,
+		})
+	}
+
+	res.Clones = SortAndTruncateClones(res.Clones, opts)
+	res.Stats.Duration = time.Since(start)
+	return res, nil
+}
+
+// SortAndTruncateClones orders clones by descending similarity, then by
+// repository and file name, and truncates them to opts.MaxResults.
+func SortAndTruncateClones(clones []zoekt.Clone, opts *zoekt.CloneOptions) []zoekt.Clone {
+	slices.SortFunc(clones, func(a, b zoekt.Clone) int {
+		if a.Similarity != b.Similarity {
+			return cmp.Compare(b.Similarity, a.Similarity)
+		}
+		if c := cmp.Compare(a.Repository, b.Repository); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.FileName, b.FileName)
+	})
+	if opts != nil && opts.MaxResults > 0 && len(clones) > opts.MaxResults {
+		clones = clones[:opts.MaxResults]
+	}
+	return clones
+}
@@ -0,0 +1,86 @@
+package index
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+const cloneSource = `func merge(a, b []int) []int {
+	out := make([]int, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if a[0] <= b[0] {
+			out = append(out, a[0])
+			a = a[1:]
+		} else {
+			out = append(out, b[0])
+			b = b[1:]
+		}
+	}
+	out = append(out, a...)
+	return append(out, b...)
+}
+`
+
+func TestSignatureSimilarity(t *testing.T) {
+	sig := computeSignature([]byte(cloneSource))
+	for _, tc := range []struct {
+		name     string
+		content  string
+		min, max float64
+	}{
+		{"identical", cloneSource, 1, 1},
+		{"reformatted", strings.ReplaceAll(cloneSource, "\t", "    "), 1, 1},
+		{"edited", strings.Replace(cloneSource, "a = a[1:]", "a = a[1:] // pop", 1), 0.5, 0.99},
+		{"unrelated", "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hello world\")\n}\n", 0, 0.2},
+	} {
+		other := computeSignature([]byte(tc.content))
+		if got := sig.similarity(&other); got < tc.min || got > tc.max {
+			t.Errorf("%s: got similarity %v, want between %v and %v", tc.name, got, tc.min, tc.max)
+		}
+	}
+
+	if computeSignature([]byte("too short")) != emptySignature {
+		t.Error("got signature for content without shingles")
+	}
+}
+
+func TestClones(t *testing.T) {
+	b := testShardBuilder(t, &zoekt.Repository{Name: "repo"},
+		Document{Name: "merge.go", Content: []byte(cloneSource)},
+		Document{Name: "vendor/merge.go", Content: []byte(strings.ReplaceAll(cloneSource, "merge", "mergeInts"))},
+		Document{Name: "main.go", Content: []byte("package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n")},
+		Document{Name: "merge.bin", Content: []byte(cloneSource + "\x00")},
+	)
+	searcher := searcherForTest(t, b)
+
+	res, err := searcher.Clones(context.Background(), []byte(cloneSource), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range res.Clones {
+		got = append(got, c.FileName)
+	}
+	if d := cmp.Diff([]string{"merge.go", "vendor/merge.go"}, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+	if res.Clones[0].Similarity != 1 || res.Clones[1].Similarity >= 1 {
+		t.Errorf("got similarities %v and %v, want 1 and less", res.Clones[0].Similarity, res.Clones[1].Similarity)
+	}
+	if res.Stats.FilesConsidered != 4 {
+		t.Errorf("got %d files considered, want 4", res.Stats.FilesConsidered)
+	}
+
+	res, err = searcher.Clones(context.Background(), []byte(cloneSource), &zoekt.CloneOptions{MinSimilarity: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Clones) != 1 {
+		t.Errorf("got %v, want only the identical file", res.Clones)
+	}
+}
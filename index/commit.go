@@ -0,0 +1,207 @@
+package index
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt/internal/syntaxutil"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// The commits section holds one entry per document. Files are encoded as a
+// single 0 byte. Commits are encoded as a 1 byte, followed by the commit date
+// in seconds as varint, the author as uvarint length and bytes, and the start
+// and end of the message as uvarints. Shards without commits leave the
+// section empty.
+
+// appendCommit appends the encoding of c, which is nil for files, to buf.
+func appendCommit(buf []byte, c *Commit) []byte {
+	if c == nil {
+		return append(buf, 0)
+	}
+
+	buf = append(buf, 1)
+	buf = binary.AppendVarint(buf, c.Date.Unix())
+	buf = binary.AppendUvarint(buf, uint64(len(c.Author)))
+	buf = append(buf, c.Author...)
+	buf = binary.AppendUvarint(buf, uint64(c.MessageStart))
+	buf = binary.AppendUvarint(buf, uint64(c.MessageEnd))
+	return buf
+}
+
+// decodeCommits decodes the commits section of a shard with numDocs
+// documents. It returns nil if the shard has no commits.
+func decodeCommits(blob []byte, numDocs int) ([]*Commit, error) {
+	if len(blob) == 0 {
+		return nil, nil
+	}
+
+	uvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(blob)
+		if n <= 0 {
+			return 0, fmt.Errorf("commits section: malformed uvarint")
+		}
+		blob = blob[n:]
+		return v, nil
+	}
+
+	commits := make([]*Commit, numDocs)
+	for i := range commits {
+		if len(blob) == 0 {
+			return nil, fmt.Errorf("commits section: got %d entries, want %d", i, numDocs)
+		}
+		kind := blob[0]
+		blob = blob[1:]
+		if kind == 0 {
+			continue
+		}
+
+		date, n := binary.Varint(blob)
+		if n <= 0 {
+			return nil, fmt.Errorf("commits section: malformed varint")
+		}
+		blob = blob[n:]
+
+		authorLen, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if authorLen > uint64(len(blob)) {
+			return nil, fmt.Errorf("commits section: author beyond end of section")
+		}
+		author := string(blob[:authorLen])
+		blob = blob[authorLen:]
+
+		start, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		end, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+
+		commits[i] = &Commit{
+			Author:       author,
+			Date:         time.Unix(date, 0),
+			MessageStart: uint32(start),
+			MessageEnd:   uint32(end),
+		}
+	}
+	return commits, nil
+}
+
+// commitDocMatchTree returns a matchTree that matches commit documents if
+// commits is true, and files otherwise.
+func (d *indexData) commitDocMatchTree(commits bool) *docMatchTree {
+	return &docMatchTree{
+		reason:  "commit",
+		numDocs: d.numDocs(),
+		predicate: func(docID uint32) bool {
+			isCommit := d.commits != nil && d.commits[docID] != nil
+			return isCommit == commits
+		},
+	}
+}
+
+// newCommitMatchTree returns the matchTree for the query atoms that only
+// apply to commits.
+func (d *indexData) newCommitMatchTree(q query.Q) (matchTree, error) {
+	if d.commits == nil {
+		return &noMatchTree{Why: "no commits"}, nil
+	}
+
+	switch s := q.(type) {
+	case *query.Author:
+		return &docMatchTree{
+			reason:  "author",
+			numDocs: d.numDocs(),
+			predicate: func(docID uint32) bool {
+				c := d.commits[docID]
+				return c != nil && s.Regexp.MatchString(c.Author)
+			},
+		}, nil
+
+	case *query.CommitDate:
+		return &docMatchTree{
+			reason:  "commitdate",
+			numDocs: d.numDocs(),
+			predicate: func(docID uint32) bool {
+				c := d.commits[docID]
+				if c == nil {
+					return false
+				}
+				if !s.After.IsZero() && c.Date.Before(s.After) {
+					return false
+				}
+				if !s.Before.IsZero() && !c.Date.Before(s.Before) {
+					return false
+				}
+				return true
+			},
+		}, nil
+
+	case *query.Message:
+		prefix := ""
+		if !s.CaseSensitive {
+			prefix = "(?i)"
+		}
+		re, err := regexp.Compile(prefix + syntaxutil.RegexpString(s.Regexp))
+		if err != nil {
+			return nil, err
+		}
+		return &messageMatchTree{
+			regexpMatchTree: regexpMatchTree{
+				regexp:     re,
+				origRegexp: s.Regexp,
+			},
+			commits: d.commits,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected commit query %T", q)
+}
+
+// messageMatchTree matches a regular expression against the message of a
+// commit document.
+type messageMatchTree struct {
+	regexpMatchTree
+
+	commits []*Commit
+}
+
+func (t *messageMatchTree) String() string {
+	return fmt.Sprintf("message(%v)", t.regexp)
+}
+
+func (t *messageMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) matchesState {
+	if t.reEvaluated {
+		return matchesStateForSlice(t.found)
+	}
+
+	c := t.commits[cp.idx]
+	if c == nil {
+		return matchesNone
+	}
+
+	if cost < costRegexp {
+		return matchesRequiresHigherCost
+	}
+
+	cp.stats.RegexpsConsidered++
+	msg := cp.data(false)[c.MessageStart:c.MessageEnd]
+	found := t.found[:0]
+	for _, idx := range t.regexp.FindAllIndex(msg, -1) {
+		found = append(found, &candidateMatch{
+			byteOffset:  c.MessageStart + uint32(idx[0]),
+			byteMatchSz: uint32(idx[1] - idx[0]),
+		})
+	}
+	t.found = found
+	t.reEvaluated = true
+
+	return matchesStateForSlice(t.found)
+}
@@ -0,0 +1,118 @@
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestCommitsRoundTrip(t *testing.T) {
+	commits := []*Commit{
+		nil,
+		{Author: "Alice <alice@example.com>", Date: time.Unix(1700000000, 0), MessageStart: 10, MessageEnd: 20},
+		nil,
+		{Author: "", Date: time.Unix(-5, 0)},
+	}
+
+	var buf []byte
+	for _, c := range commits {
+		buf = appendCommit(buf, c)
+	}
+
+	got, err := decodeCommits(buf, len(commits))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(commits, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	if _, err := decodeCommits(buf, len(commits)+1); err == nil {
+		t.Error("expected an error for a truncated section")
+	}
+	if got, err := decodeCommits(nil, 3); err != nil || got != nil {
+		t.Errorf("got %v, %v for an empty section, want nil", got, err)
+	}
+}
+
+func TestCommitSearch(t *testing.T) {
+	commitContent := "commit abc\n\nFix the bug\n\n-bug()\n+fixed()\n"
+	b := testShardBuilder(t, nil,
+		Document{Name: "f1", Content: []byte("bug fixed")},
+		Document{
+			Name:    "abc",
+			Content: []byte(commitContent),
+			Commit: &Commit{
+				Author:       "Alice <alice@example.com>",
+				Date:         time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				MessageStart: 12,
+				MessageEnd:   24,
+			},
+		},
+	)
+
+	commit := func(q query.Q) query.Q {
+		return &query.Type{Type: query.TypeCommit, Child: q}
+	}
+	mustParse := func(s string) *query.Message {
+		q, err := query.Parse("message:" + s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q.(*query.Message)
+	}
+
+	tests := []struct {
+		name string
+		q    query.Q
+		want []string
+	}{{
+		name: "files only",
+		q:    &query.Substring{Pattern: "bug"},
+		want: []string{"f1"},
+	}, {
+		name: "commits only",
+		q:    commit(&query.Substring{Pattern: "bug"}),
+		want: []string{"abc"},
+	}, {
+		name: "author",
+		q:    commit(&query.Author{Regexp: regexp.MustCompile("alice")}),
+		want: []string{"abc"},
+	}, {
+		name: "date",
+		q:    commit(&query.CommitDate{After: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}),
+		want: []string{"abc"},
+	}, {
+		name: "date excluded",
+		q:    commit(&query.CommitDate{Before: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}),
+	}, {
+		name: "message",
+		q:    commit(mustParse("bug")),
+		want: []string{"abc"},
+	}, {
+		name: "message excludes diff",
+		q:    commit(mustParse("fixed")),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := searchForTest(t, b, tt.q)
+			var got []string
+			for _, f := range res.Files {
+				got = append(got, f.FileName)
+			}
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+
+	// The message match is reported within the message.
+	res := searchForTest(t, b, commit(mustParse("bug")))
+	if len(res.Files) != 1 || len(res.Files[0].LineMatches) != 1 || res.Files[0].LineMatches[0].LineNumber != 3 {
+		t.Fatalf("got %+v, want a single match on line 3", res.Files)
+	}
+}
@@ -0,0 +1,339 @@
+package index
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+// DeltaStats summarizes how many delta builds are stacked on top of the
+// shards of a repository.
+type DeltaStats struct {
+	// Shards is the number of shards of the repository.
+	Shards int
+
+	// DeltaShards is the number of shards that were written by a later build
+	// than the first shard of the repository.
+	DeltaShards int
+
+	// FileTombstones is the number of file tombstones in the shard with the
+	// most tombstones.
+	FileTombstones int
+}
+
+// DeltaStats reads the metadata of all shards of the repository and reports
+// how fragmented they are. It returns zero stats if no shards exist.
+func (o *Options) DeltaStats() (DeltaStats, error) {
+	var stats DeltaStats
+
+	var firstID string
+	for i, shard := range o.FindAllShards() {
+		repos, md, err := ReadMetadataPathAlive(shard)
+		if err != nil {
+			return DeltaStats{}, fmt.Errorf("reading metadata from shard %q: %w", shard, err)
+		}
+
+		stats.Shards++
+		if i == 0 {
+			firstID = md.ID
+		} else if md.ID != firstID {
+			stats.DeltaShards++
+		}
+
+		for _, repo := range repos {
+			if repo.Name == o.RepositoryDescription.Name {
+				stats.FileTombstones = max(stats.FileTombstones, len(repo.FileTombstones))
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// CompactShards rewrites all shards of the repository described by opts into
+// a fresh set of shards. Documents which were tombstoned by delta builds are
+// dropped, and the new shards have no file tombstones. This has the same
+// effect as a full rebuild, without needing access to the original sources.
+//
+// A concurrent reader such as search.DirectoryWatcher never misses a live
+// document and never sees a tombstoned one: the new shards are first added
+// next to the old ones under unused shard numbers, then the old shards are
+// removed, each before its ".meta" file, and finally the new shards are
+// renamed to the usual shard numbers. Between the first two steps, live
+// documents may be found twice.
+//
+// The swap is recorded in a journal next to the shards. If CompactShards is
+// interrupted, the next CompactShards or NewBuilder for the repository
+// completes the swap if all new shards were staged, and otherwise removes the
+// staged shards.
+func CompactShards(opts Options) error {
+	opts.SetDefaults()
+
+	if err := opts.recoverCompaction(); err != nil {
+		return err
+	}
+
+	shards := opts.FindAllShards()
+	if len(shards) == 0 {
+		return fmt.Errorf("no shards found for repository %q", opts.RepositoryDescription.Name)
+	}
+	if strings.HasPrefix(filepath.Base(shards[0]), "compound-") {
+		return fmt.Errorf("compaction doesn't support repositories contained in compound shards (shard %q)", shards[0])
+	}
+
+	var ds []*indexData
+	defer func() {
+		for _, d := range ds {
+			d.Close()
+		}
+	}()
+	for _, shard := range shards {
+		f, err := os.Open(shard)
+		if err != nil {
+			return err
+		}
+		indexFile, err := NewIndexFile(f)
+		if err != nil {
+			return fmt.Errorf("opening shard %q: %w", shard, err)
+		}
+		searcher, err := NewSearcher(indexFile)
+		if err != nil {
+			indexFile.Close()
+			return fmt.Errorf("loading shard %q: %w", shard, err)
+		}
+		d := searcher.(*indexData)
+		if len(d.repoMetaData) != 1 {
+			ds = append(ds, d)
+			return fmt.Errorf("compaction expects exactly 1 repository in shard %q, found %d", shard, len(d.repoMetaData))
+		}
+		ds = append(ds, d)
+	}
+
+	// Every delta build updates the metadata of all older shards, so the
+	// latest shard has the most recent repository metadata.
+	repo := ds[len(ds)-1].repoMetaData[0]
+	repo.FileTombstones = nil
+	version := ds[0].metaData.IndexFormatVersion
+	indexTime := time.Now()
+	id := xid.NewWithTime(indexTime).String()
+
+	newShardBuilder := func() (*ShardBuilder, error) {
+		sb, err := NewShardBuilder(&repo)
+		if err != nil {
+			return nil, err
+		}
+		sb.indexFormatVersion = version
+		sb.IndexTime = indexTime
+		sb.ID = id
+		return sb, nil
+	}
+
+	var tmps []string
+	defer func() {
+		for _, tmp := range tmps {
+			os.Remove(tmp)
+		}
+	}()
+	writeShard := func(sb *ShardBuilder) error {
+		tmp := fmt.Sprintf("%s.%d.tmp", opts.shardNameVersion(version, 0), len(tmps))
+		tmps = append(tmps, tmp)
+		return builderWriteAll(tmp, sb)
+	}
+
+	sb, err := newShardBuilder()
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		tombstones := d.repoMetaData[0].FileTombstones
+		for docID := uint32(0); int(docID) < len(d.fileBranchMasks); docID++ {
+			if _, ok := tombstones[string(d.fileName(docID))]; ok {
+				continue
+			}
+
+			if err := addDocument(d, sb, 0, docID); err != nil {
+				return err
+			}
+
+			if sb.ContentSize() >= uint32(opts.ShardMax) {
+				if err := writeShard(sb); err != nil {
+					return err
+				}
+				if sb, err = newShardBuilder(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if sb.NumFiles() > 0 || len(tmps) == 0 {
+		if err := writeShard(sb); err != nil {
+			return err
+		}
+	}
+
+	// Release the old shards before replacing them. Some platforms don't
+	// allow renaming over a mapped file.
+	for _, d := range ds {
+		d.Close()
+	}
+	ds = nil
+
+	// Make the new shards visible next to the old ones. Their shard numbers
+	// follow the old ones, so no ".meta" file of an old shard applies to
+	// them.
+	j := compactJournal{shards: len(shards), staged: len(tmps)}
+	if err := opts.writeCompactJournal(version, compactStaging, j); err != nil {
+		return err
+	}
+	for i, tmp := range tmps {
+		fn := opts.shardNameVersion(version, len(shards)+i)
+		if err := os.Remove(fn + ".meta"); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := os.Rename(tmp, fn); err != nil {
+			return err
+		}
+		compactStep("stage " + fn)
+	}
+	tmps = nil
+
+	// From here on, an interrupted swap is completed instead of undone.
+	if err := opts.writeCompactJournal(version, compactSwapping, j); err != nil {
+		return err
+	}
+	compactStep("commit")
+
+	return opts.swapCompactedShards(version, j)
+}
+
+const (
+	// compactStaging is the state of a compaction journal while the new shards
+	// are staged.
+	compactStaging = "staging"
+
+	// compactSwapping is the state of a compaction journal once all new shards
+	// are staged and the old shards are being replaced.
+	compactSwapping = "swapping"
+)
+
+// compactJournal describes a swap of shards by CompactShards.
+type compactJournal struct {
+	// shards is the number of old shards.
+	shards int
+
+	// staged is the number of new shards, staged under the shard numbers
+	// following the old ones.
+	staged int
+}
+
+func (o *Options) compactJournalPath(version int) string {
+	return o.shardNameVersion(version, 0) + ".compact"
+}
+
+// writeCompactJournal atomically replaces the compaction journal of the
+// repository.
+func (o *Options) writeCompactJournal(version int, state string, j compactJournal) error {
+	fn := o.compactJournalPath(version)
+	tmp := fn + ".tmp"
+	if err := os.WriteFile(tmp, fmt.Appendf(nil, "%s %d %d\n", state, j.shards, j.staged), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, fn)
+}
+
+// swapCompactedShards replaces the old shards of a compaction by the staged
+// ones and removes the journal. It picks up where an interrupted swap left
+// off.
+func (o *Options) swapCompactedShards(version int, j compactJournal) error {
+	staged := make([]string, j.staged)
+	for i := range staged {
+		staged[i] = o.shardNameVersion(version, j.shards+i)
+	}
+
+	// The staged shards are renamed in order, after all old shards are
+	// removed. So if the first one is still staged, no rename happened yet.
+	if _, err := os.Stat(staged[0]); err == nil {
+		// Remove the old shards. The shard goes first, so that it is never read
+		// without its tombstones.
+		for i := range j.shards {
+			shard := o.shardNameVersion(version, i)
+			log.Printf("removing compacted shard file: %s", shard)
+			if err := os.Remove(shard); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			compactStep("remove " + shard)
+			if err := os.Remove(shard + ".meta"); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	// Move the new shards to the usual shard numbers, which are free now.
+	for i, fn := range staged {
+		if err := os.Rename(fn, o.shardNameVersion(version, i)); err != nil {
+			if os.IsNotExist(err) {
+				// Renamed before the swap was interrupted.
+				continue
+			}
+			return err
+		}
+		compactStep("rename " + fn)
+	}
+
+	return os.Remove(o.compactJournalPath(version))
+}
+
+// recoverCompaction finishes or undoes a swap of shards by CompactShards that
+// was interrupted, as recorded in its journal.
+func (o *Options) recoverCompaction() error {
+	for _, v := range readVersions {
+		fn := o.compactJournalPath(v.IndexFormatVersion)
+		data, err := os.ReadFile(fn)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		var state string
+		var j compactJournal
+		if _, err := fmt.Sscanf(string(data), "%s %d %d", &state, &j.shards, &j.staged); err != nil || j.staged < 1 {
+			return fmt.Errorf("reading compaction journal %q: invalid content %q", fn, data)
+		}
+
+		switch state {
+		case compactStaging:
+			// The old shards are untouched, drop the new ones.
+			for i := range j.staged {
+				staged := o.shardNameVersion(v.IndexFormatVersion, j.shards+i)
+				log.Printf("removing shard file of interrupted compaction: %s", staged)
+				if err := os.Remove(staged); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+			}
+			if err := os.Remove(fn); err != nil {
+				return err
+			}
+		case compactSwapping:
+			log.Printf("completing interrupted compaction of repository %s", o.RepositoryDescription.Name)
+			if err := o.swapCompactedShards(v.IndexFormatVersion, j); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("reading compaction journal %q: unknown state %q", fn, state)
+		}
+	}
+	return nil
+}
+
+// compactStep is called by CompactShards after each change of the index
+// directory while swapping shards. Tests use it to check what a concurrent
+// reader sees.
+var compactStep = func(step string) {}
@@ -0,0 +1,260 @@
+package index
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestCompactShards(t *testing.T) {
+	indexDir := t.TempDir()
+	opts := Options{
+		IndexDir:              indexDir,
+		DisableCTags:          true,
+		RepositoryDescription: zoekt.Repository{Name: "repo"},
+	}
+
+	build := func(isDelta bool, changed []string, docs ...Document) {
+		t.Helper()
+		buildShard(t, opts, isDelta, changed, docs...)
+	}
+	buildDeltaShards(t, opts)
+
+	stats, err := opts.DeltaStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (DeltaStats{Shards: 3, DeltaShards: 2, FileTombstones: 2}); stats != want {
+		t.Fatalf("got stats %+v before compaction, want %+v", stats, want)
+	}
+
+	// After every step of the swap, a reader loading all shards of the
+	// directory finds each live document and no tombstoned one.
+	compactStep = func(step string) {
+		t.Helper()
+		names, contents := visibleDocuments(t, indexDir)
+		for _, want := range []string{"added.go", "changed.go", "kept.go"} {
+			if !slices.Contains(names, want) {
+				t.Errorf("after %s: missing %s in %v", step, want, names)
+			}
+		}
+		if slices.Contains(names, "removed.go") || slices.Contains(contents, "old") {
+			t.Errorf("after %s: got tombstoned documents %v %q", step, names, contents)
+		}
+	}
+	defer func() { compactStep = func(string) {} }()
+
+	if err := CompactShards(opts); err != nil {
+		t.Fatal(err)
+	}
+	compactStep("compaction")
+	if names, _ := visibleDocuments(t, indexDir); len(names) != 3 {
+		t.Errorf("got documents %v after compaction, want each document once", names)
+	}
+
+	stats, err = opts.DeltaStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (DeltaStats{Shards: 1}); stats != want {
+		t.Fatalf("got stats %+v after compaction, want %+v", stats, want)
+	}
+
+	shards := opts.FindAllShards()
+	f, err := os.Open(shards[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexFile, err := NewIndexFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	searcher, err := NewSearcher(indexFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, fm := range res.Files {
+		names = append(names, fm.FileName)
+	}
+	slices.Sort(names)
+	if want := []string{"added.go", "changed.go", "kept.go"}; !slices.Equal(names, want) {
+		t.Errorf("got files %v after compaction, want %v", names, want)
+	}
+
+	res, err = searcher.Search(context.Background(), &query.Substring{Pattern: "old", Content: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 0 {
+		t.Errorf("found tombstoned content after compaction in %v", res.Files)
+	}
+
+	// Delta builds keep working on top of compacted shards.
+	build(true, []string{"kept.go"}, Document{Name: "kept.go", Content: []byte("still kept")})
+	if got := opts.FindAllShards(); len(got) != 2 || !slices.Equal(got[:1], shards) {
+		t.Fatalf("got shards %v after delta build on compacted shards", got)
+	}
+}
+
+func TestCompactShardsInterrupted(t *testing.T) {
+	newOpts := func() Options {
+		opts := Options{
+			IndexDir:              t.TempDir(),
+			DisableCTags:          true,
+			RepositoryDescription: zoekt.Repository{Name: "repo"},
+			ShardMax:              1, // one shard per document
+		}
+		buildDeltaShards(t, opts)
+		return opts
+	}
+
+	// stepName drops the index directory from the paths in step.
+	stepName := func(opts Options, step string) string {
+		return strings.ReplaceAll(step, opts.IndexDir+string(filepath.Separator), "")
+	}
+
+	var steps []string
+	opts := newOpts()
+	compactStep = func(step string) { steps = append(steps, stepName(opts, step)) }
+	defer func() { compactStep = func(string) {} }()
+	if err := CompactShards(opts); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, crash := range steps {
+		t.Run(crash, func(t *testing.T) {
+			opts := newOpts()
+
+			// Stop CompactShards right after the step, as a crash would.
+			compactStep = func(step string) {
+				if stepName(opts, step) == crash {
+					panic(crash)
+				}
+			}
+			func() {
+				defer func() {
+					if r := recover(); r != crash {
+						t.Fatalf("got %v, want crash after %s", r, crash)
+					}
+				}()
+				CompactShards(opts)
+			}()
+			compactStep = func(string) {}
+
+			if _, err := NewBuilder(opts); err != nil {
+				t.Fatal(err)
+			}
+
+			names, contents := visibleDocuments(t, opts.IndexDir)
+			if want := []string{"added.go", "changed.go", "kept.go"}; !slices.Equal(names, want) {
+				t.Errorf("got documents %v, want %v", names, want)
+			}
+			if slices.Contains(contents, "old") {
+				t.Errorf("got tombstoned content in %q", contents)
+			}
+
+			all, err := filepath.Glob(filepath.Join(opts.IndexDir, "*"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var shards []string
+			for _, fn := range all {
+				if strings.HasSuffix(fn, ".zoekt") {
+					shards = append(shards, fn)
+				} else if !strings.HasSuffix(fn, ".meta") {
+					t.Errorf("got stray file %s", fn)
+				}
+			}
+			if got := opts.FindAllShards(); !slices.Equal(got, shards) {
+				t.Errorf("found shards %v, want %v", got, shards)
+			}
+		})
+	}
+}
+
+// buildDeltaShards indexes a repository with two delta builds on top. The
+// live documents are added.go, changed.go and kept.go.
+func buildDeltaShards(t *testing.T, opts Options) {
+	t.Helper()
+	buildShard(t, opts, false, nil,
+		Document{Name: "changed.go", Content: []byte("old")},
+		Document{Name: "removed.go", Content: []byte("removed")},
+		Document{Name: "kept.go", Content: []byte("kept")},
+	)
+	buildShard(t, opts, true, []string{"changed.go", "removed.go"},
+		Document{Name: "changed.go", Content: []byte("new")},
+	)
+	buildShard(t, opts, true, nil,
+		Document{Name: "added.go", Content: []byte("added")},
+	)
+}
+
+func buildShard(t *testing.T, opts Options, isDelta bool, changed []string, docs ...Document) {
+	t.Helper()
+	opts.IsDelta = isDelta
+	b, err := NewBuilder(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range changed {
+		b.MarkFileAsChangedOrRemoved(f)
+	}
+	for _, d := range docs {
+		if err := b.Add(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Finish(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// visibleDocuments returns the names and contents of the documents in all
+// shards of dir, like search.DirectoryWatcher would load them.
+func visibleDocuments(t *testing.T, dir string) (names, contents []string) {
+	t.Helper()
+	shards, err := filepath.Glob(filepath.Join(dir, "*.zoekt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, shard := range shards {
+		f, err := os.Open(shard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		indexFile, err := NewIndexFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		searcher, err := NewSearcher(indexFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := searcher.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{Whole: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Copy the content before it is unmapped.
+		for _, fm := range res.Files {
+			names = append(names, fm.FileName)
+			contents = append(contents, string(fm.Content))
+		}
+		searcher.Close()
+	}
+	slices.Sort(names)
+	return names, contents
+}
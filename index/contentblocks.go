@@ -0,0 +1,270 @@
+package index
+
+import (
+	"container/list"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// If ShardBuilder.compressContent is set, the file contents are not written
+// to the fileContents section. Instead, the contents of all documents are
+// concatenated and split into blocks of contentBlockSize bytes, which are
+// compressed independently with zstd and written as the items of the
+// contentBlocks section. The contentBoundaries section holds the offsets of
+// the documents in the uncompressed content as big-endian uint32s, including
+// the end of the last document, so the uncompressed content of a shard must
+// be below 4 GiB.
+//
+// Reading content only decompresses the blocks it overlaps. Decompressed
+// blocks are kept in a cache shared by all shards and bounded in bytes.
+
+const (
+	contentBlockSize = 64 << 10
+
+	// contentBlocksMinReaderVersion is the feature version needed to read
+	// shards with compressed content.
+	contentBlocksMinReaderVersion = 13
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// writeContentBlocks writes the content of docs in compressed blocks to
+// blocks, and the offsets of the documents to boundaries.
+func writeContentBlocks(w *writer, blocks *compoundSection, boundaries *simpleSection, docs []*searchableString) {
+	boundaries.start(w)
+	var off uint64
+	w.U32(0)
+	for _, d := range docs {
+		off += uint64(len(d.data))
+		if off > math.MaxUint32 && w.err == nil {
+			w.err = fmt.Errorf("content has %d bytes, want at most %d", off, uint32(math.MaxUint32))
+		}
+		w.U32(uint32(off))
+	}
+	boundaries.end(w)
+	if w.err != nil {
+		return
+	}
+
+	blocks.start(w)
+	block := make([]byte, 0, contentBlockSize)
+	var compressed []byte
+	flush := func() {
+		compressed = zstdEncoder.EncodeAll(block, compressed[:0])
+		blocks.addItem(w, compressed)
+		block = block[:0]
+	}
+	for _, d := range docs {
+		data := d.data
+		for len(data) > 0 {
+			n := min(len(data), contentBlockSize-len(block))
+			block = append(block, data[:n]...)
+			data = data[n:]
+			if len(block) == contentBlockSize {
+				flush()
+			}
+		}
+	}
+	if len(block) > 0 {
+		flush()
+	}
+	blocks.end(w)
+}
+
+// contentBlocks reads content from the compressed blocks of a shard.
+type contentBlocks struct {
+	file IndexFile
+
+	// start is the offset of the first block in file.
+	start uint64
+
+	// index holds the offsets of the blocks relative to start, including
+	// the end of the last block.
+	index []uint32
+
+	// size is the size of the uncompressed content.
+	size uint32
+}
+
+func newContentBlocks(file IndexFile, blocks compoundSection, size uint32) (*contentBlocks, error) {
+	cb := &contentBlocks{
+		file:  file,
+		start: blocks.data.off,
+		index: blocks.relativeIndex(),
+		size:  size,
+	}
+	if got, want := cb.numBlocks(), (size+contentBlockSize-1)/contentBlockSize; got != want {
+		return nil, fmt.Errorf("got %d content blocks, want %d for %d bytes", got, want, size)
+	}
+	return cb, nil
+}
+
+func (cb *contentBlocks) numBlocks() uint32 {
+	if len(cb.index) == 0 {
+		return 0
+	}
+	return uint32(len(cb.index) - 1)
+}
+
+// block returns the decompressed block i.
+func (cb *contentBlocks) block(i uint32) ([]byte, error) {
+	key := contentBlockKey{blocks: cb, block: i}
+	if data, ok := contentBlockCache.get(key); ok {
+		return data, nil
+	}
+
+	compressed, err := cb.file.Read(cb.start+uint64(cb.index[i]), cb.index[i+1]-cb.index[i])
+	if err != nil {
+		return nil, err
+	}
+	data, err := zstdDecoder.DecodeAll(compressed, make([]byte, 0, contentBlockSize))
+	if err != nil {
+		return nil, fmt.Errorf("content block %d: %w", i, err)
+	}
+	contentBlockCache.add(key, data)
+	return data, nil
+}
+
+// read returns sz bytes of content starting at off. The result may be
+// shared with other callers and must not be modified.
+func (cb *contentBlocks) read(off, sz uint32) ([]byte, error) {
+	if off > cb.size || sz > cb.size-off {
+		return nil, fmt.Errorf("content read [%d, %d) out of bounds, have %d bytes", off, uint64(off)+uint64(sz), cb.size)
+	}
+	if sz == 0 {
+		return []byte{}, nil
+	}
+
+	first, last := off/contentBlockSize, (off+sz-1)/contentBlockSize
+	if first == last {
+		data, err := cb.block(first)
+		if err != nil {
+			return nil, err
+		}
+		start := off % contentBlockSize
+		return data[start : start+sz], nil
+	}
+
+	out := make([]byte, 0, sz)
+	for i := first; i <= last; i++ {
+		data, err := cb.block(i)
+		if err != nil {
+			return nil, err
+		}
+		start, end := uint32(0), uint32(len(data))
+		if i == first {
+			start = off % contentBlockSize
+		}
+		if i == last {
+			end = (off+sz-1)%contentBlockSize + 1
+		}
+		out = append(out, data[start:end]...)
+	}
+	return out, nil
+}
+
+// close drops the blocks of cb from the cache.
+func (cb *contentBlocks) close() {
+	contentBlockCache.purge(func(k contentBlockKey) bool { return k.blocks == cb })
+}
+
+type contentBlockKey struct {
+	blocks *contentBlocks
+	block  uint32
+}
+
+type blockCacheEntry[K comparable] struct {
+	key  K
+	data []byte
+}
+
+// blockCache is a cache for blocks of data with LRU eviction, such as
+// decompressed content blocks.
+type blockCache[K comparable] struct {
+	maxBytes int
+
+	mu      sync.Mutex
+	bytes   int
+	lru     *list.List
+	entries map[K]*list.Element
+}
+
+// defaultContentBlockCacheMB is the size of the content block cache if the
+// ZOEKT_CONTENT_BLOCK_CACHE_MB environment variable isn't set.
+const defaultContentBlockCacheMB = 64
+
+var contentBlockCache = newBlockCache[contentBlockKey](cacheBytesFromEnv("ZOEKT_CONTENT_BLOCK_CACHE_MB", defaultContentBlockCacheMB))
+
+// cacheBytesFromEnv returns the size of a cache in bytes, configured in MiB
+// by the environment variable name.
+func cacheBytesFromEnv(name string, defaultMB int) int {
+	mb := defaultMB
+	if v := os.Getenv(name); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			mb = n
+		}
+	}
+	return mb << 20
+}
+
+// newBlockCache creates a new blockCache holding at most maxBytes of
+// blocks. If maxBytes is 0, nothing is cached.
+func newBlockCache[K comparable](maxBytes int) *blockCache[K] {
+	return &blockCache[K]{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[K]*list.Element),
+	}
+}
+
+func (c *blockCache[K]) get(k K) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*blockCacheEntry[K]).data, true
+}
+
+func (c *blockCache[K]) add(k K, data []byte) {
+	if len(data) > c.maxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[k]; ok {
+		return
+	}
+	c.entries[k] = c.lru.PushFront(&blockCacheEntry[K]{key: k, data: data})
+	c.bytes += len(data)
+	for c.bytes > c.maxBytes {
+		c.remove(c.lru.Back())
+	}
+}
+
+// purge drops the blocks whose key matches.
+func (c *blockCache[K]) purge(match func(K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if match(k) {
+			c.remove(e)
+		}
+	}
+}
+
+func (c *blockCache[K]) remove(e *list.Element) {
+	ent := c.lru.Remove(e).(*blockCacheEntry[K])
+	delete(c.entries, ent.key)
+	c.bytes -= len(ent.data)
+}
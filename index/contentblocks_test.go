@@ -0,0 +1,143 @@
+package index
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func compressedContentDocs() []Document {
+	var large strings.Builder
+	for i := 0; large.Len() < 3*contentBlockSize; i++ {
+		fmt.Fprintf(&large, "line %d: the quick brown fox jumps over the lazy dög\n", i)
+	}
+	return []Document{
+		{Name: "small.txt", Content: []byte("needle in a small file\n")},
+		{Name: "large.txt", Content: []byte(large.String())},
+		{Name: "empty.txt", Content: []byte{}},
+		{Name: "unicode.txt", Content: []byte("ünïcödé needle\nsecond line\n")},
+	}
+}
+
+func TestContentBlocks(t *testing.T) {
+	docs := compressedContentDocs()
+
+	plain := testShardBuilder(t, &zoekt.Repository{Name: "repo"}, docs...)
+	compressed := testShardBuilder(t, &zoekt.Repository{Name: "repo"}, docs...)
+	compressed.compressContent = true
+
+	var plainBuf, compressedBuf bytes.Buffer
+	if err := plain.Write(&plainBuf); err != nil {
+		t.Fatal(err)
+	}
+	if err := compressed.Write(&compressedBuf); err != nil {
+		t.Fatal(err)
+	}
+	if compressedBuf.Len() >= plainBuf.Len() {
+		t.Errorf("got compressed shard of %d bytes, want less than %d", compressedBuf.Len(), plainBuf.Len())
+	}
+
+	d, err := loadIndexData(&memSeeker{compressedBuf.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if d.contentBlocks == nil {
+		t.Fatal("got no content blocks")
+	}
+	if got := d.metaData.IndexMinReaderVersion; got != contentBlocksMinReaderVersion {
+		t.Errorf("got min reader version %d, want %d", got, contentBlocksMinReaderVersion)
+	}
+
+	for i, doc := range docs {
+		got, err := d.readContents(uint32(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, doc.Content) {
+			t.Errorf("%s: got different content", doc.Name)
+		}
+	}
+
+	// A slice across a block boundary is stitched together, and a slice
+	// past the end is capped.
+	all := bytes.Join([][]byte{docs[0].Content, docs[1].Content, docs[2].Content, docs[3].Content}, nil)
+	for _, tc := range []struct{ off, sz uint32 }{
+		{contentBlockSize - 10, 20},
+		{contentBlockSize - 10, 2*contentBlockSize + 20},
+		{uint32(len(all)) - 5, 100},
+	} {
+		got, err := d.readContentSlice(tc.off, tc.sz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := all[tc.off:min(int(tc.off+tc.sz), len(all))]
+		if !bytes.Equal(got, want) {
+			t.Errorf("readContentSlice(%d, %d): got %q, want %q", tc.off, tc.sz, got, want)
+		}
+	}
+
+	plainSearcher := searcherForTest(t, plain)
+	for _, q := range []query.Q{
+		&query.Substring{Pattern: "needle", Content: true},
+		&query.Substring{Pattern: "lazy dög", Content: true},
+		&query.Regexp{Regexp: mustParseRE("line 2000:.*dög"), Content: true},
+	} {
+		opts := &zoekt.SearchOptions{ChunkMatches: true}
+		want, err := plainSearcher.Search(context.Background(), q, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := d.Search(context.Background(), q, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(want.Files) == 0 {
+			t.Fatalf("%s: got no results in the uncompressed shard", q)
+		}
+		if diff := cmp.Diff(want.Files, got.Files); diff != "" {
+			t.Errorf("%s: mismatch (-uncompressed +compressed):\n%s", q, diff)
+		}
+	}
+}
+
+func TestBlockCache(t *testing.T) {
+	c := newBlockCache[contentBlockKey](10)
+	a, b := &contentBlocks{}, &contentBlocks{}
+
+	c.add(contentBlockKey{a, 0}, []byte("aaaa"))
+	c.add(contentBlockKey{a, 1}, []byte("bbbb"))
+	if _, ok := c.get(contentBlockKey{a, 0}); !ok {
+		t.Fatal("block 0 missing")
+	}
+
+	// Block 1 is the least recently used one.
+	c.add(contentBlockKey{b, 0}, []byte("cccc"))
+	if _, ok := c.get(contentBlockKey{a, 1}); ok {
+		t.Error("block 1 was not evicted")
+	}
+	if c.bytes != 8 {
+		t.Errorf("got %d bytes, want 8", c.bytes)
+	}
+
+	// Blocks larger than the cache aren't cached.
+	c.add(contentBlockKey{b, 1}, make([]byte, 11))
+	if _, ok := c.get(contentBlockKey{b, 1}); ok {
+		t.Error("got block larger than the cache")
+	}
+
+	c.purge(func(k contentBlockKey) bool { return k.blocks == a })
+	if _, ok := c.get(contentBlockKey{a, 0}); ok {
+		t.Error("block of purged blocks still cached")
+	}
+	if _, ok := c.get(contentBlockKey{b, 0}); !ok {
+		t.Error("block of other blocks was purged")
+	}
+}
@@ -36,7 +36,7 @@ type contentProvider struct {
 	stats *zoekt.Stats
 
 	// mutable
-	err      error
+	err      error // first error reading the index; Search returns it
 	idx      uint32
 	_data    []byte
 	_nl      []uint32
@@ -58,10 +58,21 @@ func (p *contentProvider) setDocument(docID uint32) {
 	p._data = nil
 }
 
+// setErr records err if it is the first error.
+func (p *contentProvider) setErr(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
 func (p *contentProvider) docSections() []DocumentSection {
 	if p._sects == nil {
-		var sz uint32
-		p._sects, sz, p.err = p.id.readDocSections(p.idx, p._sectBuf)
+		var (
+			sz  uint32
+			err error
+		)
+		p._sects, sz, err = p.id.readDocSections(p.idx, p._sectBuf)
+		p.setErr(err)
 		p.stats.ContentBytesLoaded += int64(sz)
 		p._sectBuf = p._sects
 	}
@@ -70,8 +81,12 @@ func (p *contentProvider) docSections() []DocumentSection {
 
 func (p *contentProvider) newlines() newlines {
 	if p._nl == nil {
-		var sz uint32
-		p._nl, sz, p.err = p.id.readNewlines(p.idx, p._nlBuf)
+		var (
+			sz  uint32
+			err error
+		)
+		p._nl, sz, err = p.id.readNewlines(p.idx, p._nlBuf)
+		p.setErr(err)
 		p._nlBuf = p._nl
 		p.stats.ContentBytesLoaded += int64(sz)
 	}
@@ -84,7 +99,9 @@ func (p *contentProvider) data(fileName bool) []byte {
 	}
 
 	if p._data == nil {
-		p._data, p.err = p.id.readContents(p.idx)
+		var err error
+		p._data, err = p.id.readContents(p.idx)
+		p.setErr(err)
 		p.stats.FilesLoaded++
 		p.stats.ContentBytesLoaded += int64(len(p._data))
 	}
@@ -120,8 +137,10 @@ func (p *contentProvider) findOffset(filename bool, r uint32) uint32 {
 	if filename {
 		data = p.id.fileNameContent[byteOff:]
 	} else {
-		data, p.err = p.id.readContentSlice(byteOff, 3*runeOffsetFrequency)
-		if p.err != nil {
+		var err error
+		data, err = p.id.readContentSlice(byteOff, 3*runeOffsetFrequency)
+		if err != nil {
+			p.setErr(err)
 			return 0
 		}
 	}
@@ -909,6 +928,15 @@ func SortFiles(ms []zoekt.FileMatch) {
 	boostNovelExtension(ms, 2, 0.9)
 }
 
+// SortFilesForPagination sorts file matches strictly in the order of
+// zoekt.Cursor.Compare. Unlike SortFiles, it doesn't boost novel extensions,
+// so that each page of results continues where the previous one stopped.
+func SortFilesForPagination(ms []zoekt.FileMatch) {
+	sort.Slice(ms, func(i, j int) bool {
+		return zoekt.CursorFor(&ms[i]).Compare(zoekt.CursorFor(&ms[j])) < 0
+	})
+}
+
 func boostNovelExtension(ms []zoekt.FileMatch, boostOffset int, minScoreRatio float64) {
 	if len(ms) <= boostOffset+1 {
 		return
@@ -51,7 +51,7 @@ func parseSymbols(todo []*Document, languageMap ctags.LanguageMap, parserBins ct
 	defer parser.Close()
 
 	for _, doc := range todo {
-		if len(doc.Content) == 0 || doc.Symbols != nil {
+		if len(doc.Content) == 0 || doc.Symbols != nil || doc.Commit != nil {
 			continue
 		}
 
@@ -1,6 +1,10 @@
 package index
 
-import "github.com/sourcegraph/zoekt"
+import (
+	"time"
+
+	"github.com/sourcegraph/zoekt"
+)
 
 // Document holds a document (file) to index.
 type Document struct {
@@ -16,6 +20,61 @@ type Document struct {
 	// Document sections for symbols. Offsets should use bytes.
 	Symbols         []DocumentSection
 	SymbolsMetaData []*zoekt.Symbol
+
+	// Commit is set if the document describes a commit rather than a file.
+	// Commit documents are only returned by commit queries, see
+	// query.IsCommitQuery.
+	Commit *Commit
+
+	// Blame attributes the lines of Content to the commits that last changed
+	// them, in order. It may be nil if blame wasn't computed.
+	Blame []BlameHunk
+
+	// Occurrences holds precise definitions of and references to symbols,
+	// e.g. from a SCIP index. They may overlap.
+	Occurrences []Occurrence
+}
+
+// Occurrence is a precise definition of or reference to a symbol.
+type Occurrence struct {
+	// Start and End delimit the occurrence within Content. Offsets use
+	// bytes.
+	Start, End uint32
+
+	// Symbol identifies the symbol across documents and repositories, like
+	// a SCIP symbol.
+	Symbol string
+
+	// Name is the name of the symbol matched by def: and ref: queries.
+	Name string
+
+	// Documentation is the hover documentation of the symbol.
+	Documentation string
+
+	// Definition is set if the occurrence defines Symbol.
+	Definition bool
+}
+
+// BlameHunk is a run of consecutive lines that were last changed by the same
+// commit.
+type BlameHunk struct {
+	// Lines is the number of lines in the hunk.
+	Lines uint32
+
+	zoekt.Blame
+}
+
+// Commit holds the metadata of a commit document.
+type Commit struct {
+	// Author is the author of the commit, formatted as "Name <email>".
+	Author string
+
+	// Date is the commit date.
+	Date time.Time
+
+	// MessageStart and MessageEnd delimit the commit message within the
+	// content of the document. Offsets use bytes.
+	MessageStart, MessageEnd uint32
 }
 
 type SkipReason int
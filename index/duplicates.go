@@ -0,0 +1,73 @@
+package index
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// CollapseDuplicates collapses files with the same checksum into the one
+// with the highest score, or the first one if they score the same. The
+// locations of the collapsed files, and the files they were collapsed with
+// earlier, are added to FileMatch.AlsoIn of the remaining file in repository
+// and file name order. Files without a checksum are kept as they are. The
+// order of the remaining files is preserved.
+func CollapseDuplicates(files []zoekt.FileMatch) []zoekt.FileMatch {
+	best := make(map[string]int, len(files))
+	hasDuplicates := false
+	for i := range files {
+		if len(files[i].Checksum) == 0 {
+			continue
+		}
+		key := string(files[i].Checksum)
+		j, ok := best[key]
+		if !ok || files[i].Score > files[j].Score {
+			best[key] = i
+		}
+		hasDuplicates = hasDuplicates || ok
+	}
+	if !hasDuplicates {
+		return files
+	}
+
+	// Collect the locations collapsed into each remaining file.
+	collapsed := map[int][]zoekt.FileLocation{}
+	for i := range files {
+		if len(files[i].Checksum) == 0 {
+			continue
+		}
+		j := best[string(files[i].Checksum)]
+		if i == j {
+			continue
+		}
+		collapsed[j] = append(collapsed[j], zoekt.FileLocation{
+			Repository: files[i].Repository,
+			FileName:   files[i].FileName,
+			Branches:   files[i].Branches,
+			Version:    files[i].Version,
+		})
+		collapsed[j] = append(collapsed[j], files[i].AlsoIn...)
+	}
+
+	out := files[:0]
+	for i := range files {
+		if len(files[i].Checksum) > 0 && best[string(files[i].Checksum)] != i {
+			continue
+		}
+		fm := files[i]
+		if locs, ok := collapsed[i]; ok {
+			fm.AlsoIn = append(slices.Clip(fm.AlsoIn), locs...)
+			slices.SortFunc(fm.AlsoIn, compareFileLocations)
+		}
+		out = append(out, fm)
+	}
+	return out
+}
+
+func compareFileLocations(a, b zoekt.FileLocation) int {
+	if c := cmp.Compare(a.Repository, b.Repository); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.FileName, b.FileName)
+}
@@ -0,0 +1,67 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestCollapseDuplicates(t *testing.T) {
+	files := []zoekt.FileMatch{
+		{Repository: "a", FileName: "x.go", Checksum: []byte{1}, Score: 1},
+		{Repository: "b", FileName: "x.go", Checksum: []byte{1}, Score: 2},
+		{Repository: "c", FileName: "y.go", Checksum: []byte{2}},
+		{Repository: "c", FileName: "z.go"},
+		{Repository: "d", FileName: "z.go"},
+		{
+			Repository: "e", FileName: "vendor/x.go", Checksum: []byte{1}, Score: 2,
+			AlsoIn: []zoekt.FileLocation{{Repository: "f", FileName: "x.go"}},
+		},
+	}
+	want := []zoekt.FileMatch{
+		{
+			Repository: "b", FileName: "x.go", Checksum: []byte{1}, Score: 2,
+			AlsoIn: []zoekt.FileLocation{
+				{Repository: "a", FileName: "x.go"},
+				{Repository: "e", FileName: "vendor/x.go"},
+				{Repository: "f", FileName: "x.go"},
+			},
+		},
+		{Repository: "c", FileName: "y.go", Checksum: []byte{2}},
+		{Repository: "c", FileName: "z.go"},
+		{Repository: "d", FileName: "z.go"},
+	}
+	if d := cmp.Diff(want, CollapseDuplicates(files)); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestCollapseDuplicatesSearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "util.go", Content: []byte("func needle() {}")},
+		Document{Name: "vendor/util.go", Content: []byte("func needle() {}")},
+		Document{Name: "other.go", Content: []byte("needle := 1")},
+	)
+	q := &query.Substring{Pattern: "needle", Content: true}
+
+	res := searchForTest(t, b, q)
+	if len(res.Files) != 3 {
+		t.Fatalf("got %d files, want 3", len(res.Files))
+	}
+
+	res = searchForTest(t, b, q, zoekt.SearchOptions{CollapseDuplicates: true})
+	var got []string
+	for _, f := range res.Files {
+		got = append(got, f.FileName)
+		for _, l := range f.AlsoIn {
+			got = append(got, "also in "+l.FileName)
+		}
+	}
+	// util.go scores higher than vendor/util.go.
+	if d := cmp.Diff([]string{"util.go", "also in vendor/util.go", "other.go"}, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
@@ -118,6 +118,12 @@ func (d *indexData) simplify(in query.Q) query.Q {
 			if !has {
 				return &query.Const{Value: false}
 			}
+		case *query.Category:
+			// Shards from before file categories were indexed have no
+			// categories section, so none of their files match.
+			if len(d.categories) == 0 {
+				return &query.Const{Value: false}
+			}
 		case *query.Meta:
 			return d.simplifyMultiRepo(q, func(repo *zoekt.Repository) bool {
 				if repo.Metadata == nil {
@@ -135,7 +141,13 @@ func (d *indexData) simplify(in query.Q) query.Q {
 	return query.Simplify(eval)
 }
 
-func (d *indexData) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (sr *zoekt.SearchResult, err error) {
+func (d *indexData) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	return d.search(ctx, q, opts, nil)
+}
+
+// search implements Search. If ex is non-nil, it records how q was evaluated
+// in ex.
+func (d *indexData) search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions, ex *zoekt.ShardExplanation) (sr *zoekt.SearchResult, err error) {
 	timer := newTimer()
 
 	copyOpts := *opts
@@ -155,6 +167,9 @@ func (d *indexData) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOpt
 	}
 
 	q = d.simplify(q)
+	if ex != nil {
+		ex.Query = q.String()
+	}
 	if c, ok := q.(*query.Const); ok && !c.Value {
 		return &res, nil
 	}
@@ -164,20 +179,54 @@ func (d *indexData) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOpt
 		return &res, nil
 	}
 
+	// Commit documents are only returned by commit queries, which never
+	// return files.
+	isCommitQuery := query.IsCommitQuery(q)
+	if isCommitQuery && d.commits == nil {
+		return &res, nil
+	}
+
+	// Searchers over several shards have resolved the definitions ranking
+	// refs: queries already. Otherwise, only this shard's definitions count.
+	q, err = ResolveSymbolRefs(ctx, q, d.Search)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.FuzzyFileNames {
+		q = query.Map(q, query.FuzzyFileNames)
+	}
+
+	var captureRe *regexp.Regexp
+	if opts.CaptureRegexp != "" {
+		captureRe, err = regexp.Compile(opts.CaptureRegexp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CaptureRegexp: %w", err)
+		}
+	}
 	q = query.Map(q, query.ExpandFileContent)
 
 	mt, err := d.newMatchTree(q, matchTreeOpt{})
 	if err != nil {
 		return nil, err
 	}
+	if d.commits != nil {
+		mt = &andMatchTree{[]matchTree{mt, d.commitDocMatchTree(isCommitQuery)}}
+	}
 
 	// Capture the costs of construction before pruning
 	updateMatchTreeStats(mt, &res.Stats)
+	if ex != nil {
+		ex.Ngrams = explainNgrams(mt)
+	}
 
 	mt, err = pruneMatchTree(mt)
 	if err != nil {
 		return nil, err
 	}
+	if ex != nil && mt != nil {
+		ex.MatchTree = fmt.Sprint(mt)
+	}
 	res.Stats.MatchTreeConstruction = timer.Elapsed()
 	if mt == nil {
 		res.Stats.ShardsSkippedFilter++
@@ -203,6 +252,10 @@ func (d *indexData) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOpt
 
 nextFileMatch:
 	for {
+		if cp.err != nil {
+			return nil, cp.err
+		}
+
 		canceled := false
 		select {
 		case <-ctx.Done():
@@ -322,10 +375,23 @@ nextFileMatch:
 
 		if opts.ChunkMatches {
 			fileMatch.ChunkMatches = cp.fillChunkMatches(finalCands, opts.NumContextLines, fileMatch.Language, opts)
+			if opts.SyntaxTokens {
+				annotateSyntaxTokens(fileMatch.ChunkMatches, fileMatch.Language, cp.data(false))
+			}
 		} else {
 			fileMatch.LineMatches = cp.fillMatches(finalCands, opts.NumContextLines, fileMatch.Language, opts)
 		}
 
+		if opts.Blame {
+			if err := d.annotateBlame(&fileMatch, nextDoc); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := d.annotateOccurrences(&fileMatch, nextDoc); err != nil {
+			return nil, err
+		}
+
 		if opts.UseBM25Scoring {
 			d.scoreFileBM25(&fileMatch, nextDoc, finalCands, cp, opts)
 		} else {
@@ -339,6 +405,9 @@ nextFileMatch:
 		if opts.Whole {
 			fileMatch.Content = cp.data(false)
 		}
+		if captureRe != nil {
+			fileMatch.Captures = captureMatchedLines(captureRe, cp.data(false), finalCands)
+		}
 
 		matchedChunkRanges := 0
 		for _, cm := range fileMatch.ChunkMatches {
@@ -350,11 +419,27 @@ nextFileMatch:
 
 		res.Files = append(res.Files, fileMatch)
 
+		// Facets describe files, which commit queries never return.
+		if opts.Facets && !isCommitQuery {
+			d.countFacets(&res, &fileMatch, nextDoc)
+		}
+		if captureRe != nil {
+			countCaptures(&res, &fileMatch)
+		}
+
 		res.Stats.MatchCount += len(fileMatch.LineMatches)
 		res.Stats.MatchCount += matchedChunkRanges
 		res.Stats.FileCount++
 	}
 
+	if cp.err != nil {
+		return nil, cp.err
+	}
+
+	if opts.CollapseDuplicates {
+		res.Files = CollapseDuplicates(res.Files)
+	}
+
 	for _, md := range d.repoMetaData {
 		r := md
 		addRepo(&res, &r)
@@ -393,18 +478,8 @@ func addRepo(res *zoekt.SearchResult, repo *zoekt.Repository) {
 func (d *indexData) gatherMatches(nextDoc uint32, mt matchTree, known map[matchTree]bool) []*candidateMatch {
 	var cands []*candidateMatch
 	visitMatches(mt, known, 1, func(mt matchTree, scoreWeight float64) {
-		if smt, ok := mt.(*substrMatchTree); ok {
-			cands = append(cands, setScoreWeight(scoreWeight, smt.current)...)
-		}
-		if rmt, ok := mt.(*regexpMatchTree); ok {
-			cands = append(cands, setScoreWeight(scoreWeight, rmt.found)...)
-		}
-		if rmt, ok := mt.(*wordMatchTree); ok {
-			cands = append(cands, setScoreWeight(scoreWeight, rmt.found)...)
-		}
-		if smt, ok := mt.(*symbolRegexpMatchTree); ok {
-			cands = append(cands, setScoreWeight(scoreWeight, smt.found)...)
-		}
+		cms, weight := atomMatches(mt)
+		cands = append(cands, setScoreWeight(scoreWeight*weight, cms)...)
 	})
 
 	// If we found no candidate matches at all, assume there must have been a match on filename.
@@ -450,6 +525,35 @@ func (d *indexData) gatherMatches(nextDoc uint32, mt matchTree, known map[matchT
 	return res
 }
 
+// atomMatches returns the candidate matches of the atom mt in the current
+// document, and the factor to scale their score weight by.
+func atomMatches(mt matchTree) ([]*candidateMatch, float64) {
+	switch mt := mt.(type) {
+	case *substrMatchTree:
+		return mt.current, 1
+	case *regexpMatchTree:
+		return mt.found, 1
+	case *messageMatchTree:
+		return mt.found, 1
+	case *occurrenceMatchTree:
+		return mt.found, 1
+	case *fuzzyFileNameMatchTree:
+		// Better fuzzy matches rank higher.
+		return mt.found, 1 + mt.score
+	case *structuralMatchTree:
+		return mt.found, 1
+	case *nearMatchTree:
+		return mt.found, 1
+	case *symbolRefsMatchTree:
+		return mt.found, mt.boost
+	case *wordMatchTree:
+		return mt.found, 1
+	case *symbolRegexpMatchTree:
+		return mt.found, 1
+	}
+	return nil, 1
+}
+
 type sortByOffsetSlice []*candidateMatch
 
 func (m sortByOffsetSlice) Len() int      { return len(m) }
@@ -508,6 +612,12 @@ func (d *indexData) gatherBranches(docID uint32, mt matchTree, known map[matchTr
 		mask = d.fileBranchMasks[docID]
 	}
 
+	return d.branchNamesForMask(docID, mask)
+}
+
+// branchNamesForMask returns the names of the branches in mask of the
+// repository of docID.
+func (d *indexData) branchNamesForMask(docID uint32, mask uint64) []string {
 	var branches []string
 	id := uint64(1)
 	branchNames := d.branchNames[d.repos[docID]]
@@ -522,6 +632,28 @@ func (d *indexData) gatherBranches(docID uint32, mt matchTree, known map[matchTr
 	return branches
 }
 
+func (d *indexData) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	return d.Search(ctx, &query.Occurrence{Symbol: symbol}, opts)
+}
+
+func (d *indexData) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.Explanation, error) {
+	start := time.Now()
+	ex := zoekt.ShardExplanation{Shard: d.String()}
+	res, err := d.search(ctx, q, opts, &ex)
+	if err != nil {
+		return nil, err
+	}
+	ex.Stats = res.Stats
+	ex.Duration = time.Since(start)
+
+	return &zoekt.Explanation{
+		Query:    q.String(),
+		Shards:   []zoekt.ShardExplanation{ex},
+		Stats:    res.Stats,
+		Duration: ex.Duration,
+	}, nil
+}
+
 func (d *indexData) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (rl *zoekt.RepoList, err error) {
 	var include func(rle *zoekt.RepoListEntry) bool
 
@@ -15,7 +15,9 @@
 package index
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"hash/fnv"
 	"reflect"
 	"regexp/syntax"
@@ -488,3 +490,96 @@ func TestSimplifyMeta(t *testing.T) {
 		t.Errorf("simplify(none): got %v, want Const(false)", got)
 	}
 }
+
+func TestExplain(t *testing.T) {
+	b := testShardBuilder(t, &zoekt.Repository{Name: "repo"},
+		Document{Name: "a.go", Content: []byte("needle in a haystack")},
+		Document{Name: "b.go", Content: []byte("haystack")},
+	)
+	searcher := searcherForTest(t, b)
+
+	q := &query.Substring{Pattern: "needle", Content: true}
+	ex, err := searcher.Explain(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ex.Shards) != 1 {
+		t.Fatalf("got %d shard explanations, want 1", len(ex.Shards))
+	}
+	got := ex.Shards[0]
+	if got.Query != q.String() {
+		t.Errorf("got query %s, want %s", got.Query, q)
+	}
+	if !strings.HasPrefix(got.MatchTree, `substr("needle"`) {
+		t.Errorf("got match tree %s, want substr(\"needle\", ...)", got.MatchTree)
+	}
+	if got.Stats.MatchCount != 1 || ex.Stats.MatchCount != 1 {
+		t.Errorf("got stats %+v, want MatchCount 1", got.Stats)
+	}
+	if len(got.Ngrams) != 1 || got.Ngrams[0].Pattern != "needle" || len(got.Ngrams[0].Ngrams) != 2 {
+		t.Fatalf("got ngrams %+v, want two ngrams of needle", got.Ngrams)
+	}
+	for i, sz := range got.Ngrams[0].PostingListSizes {
+		if sz == 0 {
+			t.Errorf("got empty posting list for ngram %q", got.Ngrams[0].Ngrams[i])
+		}
+	}
+
+	// A missing ngram is reported, and the shard can't match.
+	ex, err = searcher.Explain(context.Background(), &query.Substring{Pattern: "needlex", Content: true}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []zoekt.NgramExplanation{{
+		Pattern:          "needlex",
+		Ngrams:           []string{"lex"},
+		PostingListSizes: []uint32{0},
+	}}
+	if d := cmp.Diff(want, ex.Shards[0].Ngrams); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+	if ex.Shards[0].MatchTree != "" {
+		t.Errorf("got match tree %s, want none", ex.Shards[0].MatchTree)
+	}
+}
+
+// failingIndexFile fails all reads once fail is set.
+type failingIndexFile struct {
+	IndexFile
+	fail bool
+}
+
+func (f *failingIndexFile) Read(off uint64, sz uint32) ([]byte, error) {
+	if f.fail {
+		return nil, errors.New("read failed")
+	}
+	return f.IndexFile.Read(off, sz)
+}
+
+func TestSearchReadError(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "f1", Content: []byte("to carry water in the no later bla")},
+		Document{Name: "f2", Content: []byte("bla the needle")})
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	f := &failingIndexFile{IndexFile: &memSeeker{buf.Bytes()}}
+	s, err := NewSearcher(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	f.fail = true
+
+	for _, q := range []query.Q{
+		// Reads the posting lists.
+		&query.Substring{Pattern: "needle"},
+		// Reads the content, because there are no ngrams to look up.
+		&query.Regexp{Regexp: mustParseRE("n.e")},
+	} {
+		if _, err := s.Search(context.Background(), q, &zoekt.SearchOptions{}); err == nil {
+			t.Errorf("%s: got no error", q)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package index
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// countFacets counts fm, the match of doc, in the facets of res.
+func (d *indexData) countFacets(res *zoekt.SearchResult, fm *zoekt.FileMatch, doc uint32) {
+	if res.Facets == nil {
+		res.Facets = &zoekt.Facets{
+			Repositories: map[string]int{},
+			Languages:    map[string]int{},
+			Categories:   map[string]int{},
+			Directories:  map[string]int{},
+		}
+	}
+	f := res.Facets
+
+	f.Repositories[fm.Repository]++
+	if fm.Language != "" {
+		f.Languages[fm.Language]++
+	}
+	if c := d.getCategory(doc); c != FileCategoryMissing {
+		f.Categories[c.String()]++
+	}
+	if dir, _, ok := strings.Cut(fm.FileName, "/"); ok {
+		f.Directories[dir]++
+	}
+}
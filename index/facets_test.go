@@ -0,0 +1,65 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestFacets(t *testing.T) {
+	b := testShardBuilder(t, &zoekt.Repository{Name: "repo"},
+		Document{Name: "cmd/main.go", Language: "Go", Content: []byte("needle")},
+		Document{Name: "cmd/main_test.go", Language: "Go", Content: []byte("needle")},
+		Document{Name: "web/app.js", Language: "JavaScript", Content: []byte("needle")},
+		Document{Name: "README.md", Content: []byte("needle")},
+		Document{Name: "web/other.js", Language: "JavaScript", Content: []byte("haystack")},
+	)
+	searcher := searcherForTest(t, b)
+	q := &query.Substring{Pattern: "needle", Content: true}
+
+	res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Facets != nil {
+		t.Fatalf("got facets %v without SearchOptions.Facets", res.Facets)
+	}
+
+	res, err = searcher.Search(context.Background(), q, &zoekt.SearchOptions{Facets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &zoekt.Facets{
+		Repositories: map[string]int{"repo": 4},
+		Languages:    map[string]int{"Go": 2, "JavaScript": 1, "Markdown": 1},
+		Categories:   map[string]int{"default": 2, "test": 1, "documentation": 1},
+		Directories:  map[string]int{"cmd": 2, "web": 1},
+	}
+	if d := cmp.Diff(want, res.Facets); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestFacetsSkipCommits(t *testing.T) {
+	b := testShardBuilder(t, &zoekt.Repository{Name: "repo"},
+		Document{Name: "cmd/main.go", Language: "Go", Content: []byte("needle")},
+		Document{Name: "abc", Content: []byte("commit abc\n\nAdd needle\n"), Commit: &Commit{MessageStart: 12, MessageEnd: 23}},
+	)
+	searcher := searcherForTest(t, b)
+
+	q := &query.Type{Type: query.TypeCommit, Child: &query.Substring{Pattern: "needle", Content: true}}
+	res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{Facets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 {
+		t.Fatalf("got %d commits, want 1", len(res.Files))
+	}
+	if res.Facets != nil {
+		t.Errorf("got facets %v for commits", res.Facets)
+	}
+}
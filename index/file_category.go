@@ -59,6 +59,41 @@ func DetermineFileCategory(doc *Document) {
 	doc.Category = category
 }
 
+// String returns the name of the category, as used in facets and category:
+// queries.
+func (c FileCategory) String() string {
+	switch c {
+	case FileCategoryDefault:
+		return "default"
+	case FileCategoryTest:
+		return "test"
+	case FileCategoryVendored:
+		return "vendored"
+	case FileCategoryGenerated:
+		return "generated"
+	case FileCategoryConfig:
+		return "config"
+	case FileCategoryDotFile:
+		return "dotfile"
+	case FileCategoryBinary:
+		return "binary"
+	case FileCategoryDocumentation:
+		return "documentation"
+	default:
+		return "missing"
+	}
+}
+
+// parseFileCategory returns the category named name, see String.
+func parseFileCategory(name string) (FileCategory, bool) {
+	for c := FileCategoryDefault; c <= FileCategoryDocumentation; c++ {
+		if c.String() == name {
+			return c, true
+		}
+	}
+	return FileCategoryMissing, false
+}
+
 // lowPriority returns true if this file category is considered 'low priority'. This is used
 // in search scoring to down-weight matches in these files.
 func (c FileCategory) lowPriority() bool {
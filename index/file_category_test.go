@@ -2,6 +2,10 @@ package index
 
 import (
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt/query"
 )
 
 func TestDetermineFileCategory(t *testing.T) {
@@ -70,3 +74,44 @@ func TestDetermineFileCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFileCategory(t *testing.T) {
+	// The query package validates category: atoms against its own list.
+	var got []string
+	for c := FileCategoryDefault; c <= FileCategoryDocumentation; c++ {
+		parsed, ok := parseFileCategory(c.String())
+		if !ok || parsed != c {
+			t.Errorf("parseFileCategory(%q) = %v, %v", c.String(), parsed, ok)
+		}
+		got = append(got, c.String())
+	}
+	if d := cmp.Diff(query.FileCategories, got); d != "" {
+		t.Errorf("query.FileCategories mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestCategorySearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "main.go", Content: []byte("needle")},
+		Document{Name: "main_test.go", Content: []byte("needle")},
+		Document{Name: "vendor/lib/lib.go", Content: []byte("needle")},
+	)
+
+	for _, tc := range []struct {
+		q    query.Q
+		want []string
+	}{
+		{&query.Category{Category: "test"}, []string{"main_test.go"}},
+		{query.NewAnd(&query.Substring{Pattern: "needle"}, &query.Not{Child: &query.Category{Category: "vendored"}}), []string{"main.go", "main_test.go"}},
+		{&query.Category{Category: "generated"}, nil},
+	} {
+		res := searchForTest(t, b, tc.q)
+		var got []string
+		for _, f := range res.Files {
+			got = append(got, f.FileName)
+		}
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+		}
+	}
+}
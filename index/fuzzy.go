@@ -0,0 +1,280 @@
+package index
+
+import (
+	"fmt"
+	"math"
+	"path"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+const (
+	// fuzzyMinTrigramSimilarity is the fraction of the trigrams of a pattern
+	// that a file name must contain to match despite typos.
+	fuzzyMinTrigramSimilarity = 0.5
+
+	// fuzzyBasenamePenalty scales the score of subsequence matches that
+	// extend into the directory part of a file name.
+	fuzzyBasenamePenalty = 0.75
+)
+
+// newFuzzyFileNameMatchTree returns a matchTree for file names matching
+// q.Pattern fuzzily. The candidates are the documents whose name contains
+// the pattern as a subsequence or shares a trigram with it, or all documents
+// for patterns shorter than a trigram.
+func (d *indexData) newFuzzyFileNameMatchTree(q *query.FuzzyFileName) (matchTree, error) {
+	pattern := []rune(string(toLower([]byte(q.Pattern))))
+	numDocs := d.numDocs()
+
+	t := &fuzzyFileNameMatchTree{
+		docMatchTree: docMatchTree{
+			reason:    "fuzzy file name",
+			numDocs:   numDocs,
+			predicate: func(uint32) bool { return true },
+		},
+		pattern:  pattern,
+		trigrams: fuzzyTrigrams(pattern),
+	}
+	if len(t.trigrams) == 0 {
+		return t, nil
+	}
+
+	candidates := make([]bool, numDocs)
+	found := false
+	for ng := range t.trigrams {
+		it, err := d.trigramHitIterator(ng, false, true)
+		if err != nil {
+			return nil, err
+		}
+		doc := uint32(0)
+		for hit := it.first(); hit != math.MaxUint32; hit = it.first() {
+			doc = nextFileIndex(hit, doc, d.fileNameEndRunes)
+			if doc >= numDocs {
+				break
+			}
+			candidates[doc] = true
+			found = true
+
+			// Skip the remaining hits in this file name.
+			it.next(d.fileNameEndRunes[doc] - 1)
+		}
+	}
+
+	// Subsequence matches need not share a trigram with the pattern, like
+	// "wsrv" for "web/server.go". File names are held in memory, so checking
+	// all of them is cheap.
+	for doc := uint32(0); doc < numDocs; doc++ {
+		if !candidates[doc] && fuzzyIsSubsequence(pattern, d.fileName(doc)) {
+			candidates[doc] = true
+			found = true
+		}
+	}
+	if !found {
+		return &noMatchTree{Why: "no fuzzy file name candidates"}, nil
+	}
+
+	t.predicate = func(docID uint32) bool { return candidates[docID] }
+	return t, nil
+}
+
+// fuzzyTrigrams returns the distinct trigrams of the lower case pattern.
+func fuzzyTrigrams(pattern []rune) map[ngram]struct{} {
+	trigrams := map[ngram]struct{}{}
+	for i := 0; i+ngramSize <= len(pattern); i++ {
+		trigrams[runesToNGram([ngramSize]rune(pattern[i:i+ngramSize]))] = struct{}{}
+	}
+	return trigrams
+}
+
+// fuzzyFileNameMatchTree matches file names that contain the characters of
+// the pattern in order, or most of its trigrams.
+type fuzzyFileNameMatchTree struct {
+	// nextDoc, prepare.
+	docMatchTree
+
+	// pattern is lower case.
+	pattern  []rune
+	trigrams map[ngram]struct{}
+
+	// mutable
+	evaluated bool
+	score     float64
+	found     []*candidateMatch
+}
+
+func (t *fuzzyFileNameMatchTree) prepare(doc uint32) {
+	t.evaluated = false
+	t.score = 0
+	t.found = t.found[:0]
+	t.docMatchTree.prepare(doc)
+}
+
+func (t *fuzzyFileNameMatchTree) String() string {
+	return fmt.Sprintf("fuzzyFile(%q)", string(t.pattern))
+}
+
+func (t *fuzzyFileNameMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) matchesState {
+	if t.evaluated {
+		return matchesStateForSlice(t.found)
+	}
+
+	if cost < costMemory {
+		return matchesRequiresHigherCost
+	}
+
+	score, ranges := fuzzyMatch(t.pattern, t.trigrams, cp.data(true))
+	found := t.found[:0]
+	for _, r := range ranges {
+		found = append(found, &candidateMatch{
+			byteOffset:  uint32(r[0]),
+			byteMatchSz: uint32(r[1] - r[0]),
+			fileName:    true,
+		})
+	}
+	t.found = found
+	t.score = score
+	t.evaluated = true
+
+	return matchesStateForSlice(t.found)
+}
+
+// fuzzyMatch matches the lower case pattern against name. It returns a score
+// in (0, 1] and the matched byte ranges of name, or no ranges if name
+// doesn't match. Names containing the pattern as a subsequence score above
+// 0.5, preferring consecutive characters, characters at word boundaries and
+// matches within the base name. Other names score by the fraction of the
+// trigrams of the pattern they contain, which must be at least
+// fuzzyMinTrigramSimilarity.
+func fuzzyMatch(pattern []rune, trigrams map[ngram]struct{}, name []byte) (float64, [][2]int) {
+	if len(pattern) == 0 {
+		return 0, nil
+	}
+
+	// The lower case runes of name and their byte offsets, with a final
+	// offset for the end of name.
+	var (
+		runes   []rune
+		offsets []int
+	)
+	for i, r := range string(name) {
+		runes = append(runes, unicode.ToLower(r))
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(name))
+
+	if positions, ok := fuzzySubsequence(pattern, runes); ok {
+		return fuzzySubsequenceScore(positions, name, offsets), fuzzyRanges(positions, 1, offsets)
+	}
+
+	if len(trigrams) == 0 {
+		return 0, nil
+	}
+	var (
+		shared    = map[ngram]struct{}{}
+		positions []int
+	)
+	for i := 0; i+ngramSize <= len(runes); i++ {
+		ng := runesToNGram([ngramSize]rune(runes[i : i+ngramSize]))
+		if _, ok := trigrams[ng]; ok {
+			shared[ng] = struct{}{}
+			positions = append(positions, i)
+		}
+	}
+	similarity := float64(len(shared)) / float64(len(trigrams))
+	if similarity < fuzzyMinTrigramSimilarity {
+		return 0, nil
+	}
+	return similarity / 2, fuzzyRanges(positions, ngramSize, offsets)
+}
+
+// fuzzyIsSubsequence returns true if the lower case pattern is a
+// subsequence of name, ignoring case.
+func fuzzyIsSubsequence(pattern []rune, name []byte) bool {
+	i := 0
+	for _, r := range string(name) {
+		if i == len(pattern) {
+			break
+		}
+		if unicode.ToLower(r) == pattern[i] {
+			i++
+		}
+	}
+	return i == len(pattern)
+}
+
+// fuzzySubsequence returns the rune positions of the last occurrence of
+// pattern as a subsequence of runes. Matching from the end prefers matches
+// in the base name.
+func fuzzySubsequence(pattern, runes []rune) ([]int, bool) {
+	positions := make([]int, len(pattern))
+	j := len(runes) - 1
+	for i := len(pattern) - 1; i >= 0; i-- {
+		for j >= 0 && runes[j] != pattern[i] {
+			j--
+		}
+		if j < 0 {
+			return nil, false
+		}
+		positions[i] = j
+		j--
+	}
+	return positions, true
+}
+
+// fuzzySubsequenceScore scores the subsequence match at positions in
+// (0.5, 1]. Among otherwise equal matches, shorter names score higher.
+func fuzzySubsequenceScore(positions []int, name []byte, offsets []int) float64 {
+	baseStart := len(name) - len(path.Base(string(name)))
+
+	points := 0
+	for i, p := range positions {
+		points++
+		if i > 0 && positions[i-1] == p-1 {
+			points++
+		}
+		if fuzzyBoundary(name, offsets, p) {
+			points++
+		}
+	}
+
+	numRunes := len(offsets) - 1
+	score := float64(points) / float64(3*len(positions))
+	score *= 0.8 + 0.2*float64(len(positions))/float64(numRunes)
+	if offsets[positions[0]] < baseStart {
+		score *= fuzzyBasenamePenalty
+	}
+	return 0.5 + score/2
+}
+
+// fuzzyBoundary returns true if the rune at position p of name starts a
+// word: it is the first rune, follows a separator or is an upper case letter
+// following a lower case one.
+func fuzzyBoundary(name []byte, offsets []int, p int) bool {
+	if p == 0 {
+		return true
+	}
+	prev, _ := utf8.DecodeRune(name[offsets[p-1]:])
+	cur, _ := utf8.DecodeRune(name[offsets[p]:])
+	switch prev {
+	case '/', '_', '-', '.', ' ':
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(cur)
+}
+
+// fuzzyRanges converts the sorted rune positions of matches of width runes
+// to merged byte ranges.
+func fuzzyRanges(positions []int, width int, offsets []int) [][2]int {
+	var ranges [][2]int
+	for _, p := range positions {
+		start, end := offsets[p], offsets[p+width]
+		if n := len(ranges); n > 0 && ranges[n-1][1] >= start {
+			ranges[n-1][1] = max(ranges[n-1][1], end)
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
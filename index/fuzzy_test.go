@@ -0,0 +1,114 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		name    string
+		want    []string
+	}{
+		{"srvmain", "server/main.go", []string{"s", "rv", "main"}},
+		{"main", "cmd/main/main.go", []string{"main"}},
+		{"FooBar", "pkg/foo_bar.go", []string{"foo", "bar"}},
+		// Transposed characters match by trigrams.
+		{"handler_tset.go", "api/handler_test.go", []string{"handler_t", "t.go"}},
+		{"xyz", "server/main.go", nil},
+	} {
+		pattern := []rune(string(toLower([]byte(tc.pattern))))
+		_, ranges := fuzzyMatch(pattern, fuzzyTrigrams(pattern), []byte(tc.name))
+		var got []string
+		for _, r := range ranges {
+			got = append(got, tc.name[r[0]:r[1]])
+		}
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("fuzzyMatch(%q, %q): mismatch (-want +got):\n%s", tc.pattern, tc.name, d)
+		}
+	}
+}
+
+func TestFuzzyMatchScore(t *testing.T) {
+	score := func(pattern, name string) float64 {
+		p := []rune(pattern)
+		s, _ := fuzzyMatch(p, fuzzyTrigrams(p), []byte(name))
+		return s
+	}
+
+	// Consecutive characters at word boundaries in the base name rank
+	// highest, then scattered characters, then typos.
+	names := []string{"server/main.go", "some/erver/main.go", "server/mian.go"}
+	var last float64 = 2
+	for _, name := range names {
+		s := score("servermain", name)
+		if s <= 0 || s >= last {
+			t.Errorf("score(%q) = %v, want in (0, %v)", name, s, last)
+		}
+		last = s
+	}
+}
+
+func TestFuzzyFileNameSearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "internal/searcher/shard.go", Content: []byte("shard")},
+		Document{Name: "search/shards.go", Content: []byte("shards")},
+		Document{Name: "web/server.go", Content: []byte("server")},
+	)
+	searcher := searcherForTest(t, b)
+
+	fileNames := func(res *zoekt.SearchResult) []string {
+		SortFiles(res.Files)
+		var names []string
+		for _, f := range res.Files {
+			names = append(names, f.FileName)
+		}
+		return names
+	}
+
+	// searchForTest clears the scores we want to check.
+	res, err := searcher.Search(context.Background(), &query.FuzzyFileName{Pattern: "srchshrd"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff([]string{"search/shards.go", "internal/searcher/shard.go"}, fileNames(res)); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	// These patterns share no trigram with the file name, so only the
+	// subsequence match finds it.
+	for _, pattern := range []string{"wsrv", "srvgo", "WebSrv"} {
+		res, err := searcher.Search(context.Background(), &query.FuzzyFileName{Pattern: pattern}, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := cmp.Diff([]string{"web/server.go"}, fileNames(res)); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", pattern, d)
+		}
+	}
+
+	t.Run("FuzzyFileNames", func(t *testing.T) {
+		q := &query.Substring{Pattern: "webserver", FileName: true}
+		res, err := searcher.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != 0 {
+			t.Fatalf("got %d files without FuzzyFileNames, want 0", len(res.Files))
+		}
+
+		res, err = searcher.Search(context.Background(), q, &zoekt.SearchOptions{FuzzyFileNames: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := cmp.Diff([]string{"web/server.go"}, fileNames(res)); d != "" {
+			t.Errorf("mismatch (-want +got):\n%s", d)
+		}
+	})
+}
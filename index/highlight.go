@@ -0,0 +1,43 @@
+package index
+
+import (
+	"sort"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/internal/highlight"
+)
+
+// annotateSyntaxTokens sets the SyntaxTokens of the content chunks of cms.
+// It tokenizes the whole document, so that comments and strings that start
+// before a chunk are classified correctly.
+func annotateSyntaxTokens(cms []zoekt.ChunkMatch, language string, content []byte) {
+	var toks []zoekt.SyntaxToken
+	tokenized := false
+	for i := range cms {
+		cm := &cms[i]
+		if cm.FileName {
+			continue
+		}
+		if !tokenized {
+			toks = highlight.Tokenize(language, content)
+			tokenized = true
+		}
+		if len(toks) == 0 {
+			return
+		}
+
+		start := cm.ContentStart.ByteOffset
+		end := start + uint32(len(cm.Content))
+
+		// The first token that ends after the start of the chunk.
+		j := sort.Search(len(toks), func(j int) bool { return toks[j].End > start })
+		for ; j < len(toks) && toks[j].Start < end; j++ {
+			t := toks[j]
+			cm.SyntaxTokens = append(cm.SyntaxTokens, zoekt.SyntaxToken{
+				Start: max(t.Start, start) - start,
+				End:   min(t.End, end) - start,
+				Class: t.Class,
+			})
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestSyntaxTokens(t *testing.T) {
+	content := "package main\n\n/*\nneedle in a comment\n*/\nvar needle = \"x\"\n"
+	b := testShardBuilder(t, nil,
+		Document{Name: "main.go", Language: "Go", Content: []byte(content)},
+		Document{Name: "needle.txt", Language: "Text", Content: []byte("needle")},
+	)
+	q := &query.Substring{Pattern: "needle"}
+
+	res := searchForTest(t, b, q, zoekt.SearchOptions{ChunkMatches: true})
+	for _, f := range res.Files {
+		for _, cm := range f.ChunkMatches {
+			if cm.SyntaxTokens != nil {
+				t.Fatalf("got syntax tokens %v without SearchOptions.SyntaxTokens", cm.SyntaxTokens)
+			}
+		}
+	}
+
+	res = searchForTest(t, b, q, zoekt.SearchOptions{ChunkMatches: true, SyntaxTokens: true})
+	got := map[string][]string{}
+	for _, f := range res.Files {
+		for _, cm := range f.ChunkMatches {
+			var toks []string
+			for _, tok := range cm.SyntaxTokens {
+				toks = append(toks, tok.Class.String()+" "+string(cm.Content[tok.Start:tok.End]))
+			}
+			got[f.FileName+":"+string(cm.Content)] = toks
+		}
+	}
+	want := map[string][]string{
+		// The comment starts before the chunk and ends after it.
+		"main.go:needle in a comment\n": {"comment needle in a comment\n"},
+		"main.go:var needle = \"x\"\n":  {"keyword var", "identifier needle", `string "x"`},
+		"needle.txt:needle":             nil,
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
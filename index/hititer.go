@@ -122,7 +122,10 @@ func (d *indexData) trigramHitIterator(ng ngram, caseSensitive, fileName bool) (
 	ngramLookups := 0
 	ngrams := d.ngrams(fileName)
 	for _, v := range variants {
-		sec := ngrams.Get(v)
+		sec, err := ngrams.Get(v)
+		if err != nil {
+			return nil, err
+		}
 		ngramLookups++
 		blob, err := d.readSectionBlob(sec)
 		if err != nil {
@@ -0,0 +1,32 @@
+package index
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// identifierSeparators join the words of an identifier in its different case
+// styles: "" for camelCase and PascalCase, "_" for snake_case and
+// SCREAMING_SNAKE_CASE and "-" for kebab-case.
+var identifierSeparators = []string{"", "_", "-"}
+
+// identifierQuery expands an Identifier into an OR of case insensitive
+// content substrings, one for each spelling of its words. Unlike a regexp,
+// each of the substrings narrows down the candidate documents with its
+// trigrams.
+func identifierQuery(q *query.Identifier) query.Q {
+	words := query.IdentifierWords(q.Name)
+	switch len(words) {
+	case 0:
+		return &query.Const{Value: false}
+	case 1:
+		return &query.Substring{Pattern: words[0], Content: true}
+	}
+
+	var children []query.Q
+	for _, sep := range identifierSeparators {
+		children = append(children, &query.Substring{Pattern: strings.Join(words, sep), Content: true})
+	}
+	return query.NewOr(children...)
+}
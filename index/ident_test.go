@@ -0,0 +1,57 @@
+package index
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestIdentifierSearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "user.go", Content: []byte("func getUserName() string")},
+		Document{Name: "user.py", Content: []byte("def get_user_name(self):")},
+		Document{Name: "user.css", Content: []byte(".get-user-name { color: red }")},
+		Document{Name: "const.py", Content: []byte("GET_USER_NAME = 1")},
+		Document{Name: "other.go", Content: []byte("func getUser() string")},
+		Document{Name: "name.txt", Content: []byte("get user name")},
+	)
+
+	for _, tc := range []struct {
+		q    query.Q
+		want []string
+	}{
+		{&query.Identifier{Name: "getUserName"}, []string{"const.py", "user.css", "user.go", "user.py"}},
+		{&query.Identifier{Name: "get_user_name"}, []string{"const.py", "user.css", "user.go", "user.py"}},
+		{&query.Identifier{Name: "getUser"}, []string{"const.py", "other.go", "user.css", "user.go", "user.py"}},
+		{&query.Identifier{Name: "user"}, []string{"const.py", "name.txt", "other.go", "user.css", "user.go", "user.py"}},
+		{&query.Identifier{Name: "__"}, nil},
+	} {
+		res := searchForTest(t, b, tc.q)
+		var names []string
+		for _, f := range res.Files {
+			names = append(names, f.FileName)
+		}
+		sort.Strings(names)
+		if d := cmp.Diff(tc.want, names); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+		}
+	}
+
+	// The matches are highlighted in the spelling of the file.
+	res := searchForTest(t, b, &query.Identifier{Name: "getUserName"})
+	for _, f := range res.Files {
+		if f.FileName != "user.py" {
+			continue
+		}
+		if len(f.LineMatches) != 1 || len(f.LineMatches[0].LineFragments) != 1 {
+			t.Fatalf("got %v, want one fragment", f.LineMatches)
+		}
+		lf := f.LineMatches[0].LineFragments[0]
+		if got := string(f.LineMatches[0].Line[lf.LineOffset : lf.LineOffset+lf.MatchLength]); got != "get_user_name" {
+			t.Errorf("got match %q, want get_user_name", got)
+		}
+	}
+}
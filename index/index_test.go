@@ -193,12 +193,12 @@ func (s *memSeeker) Name() string {
 }
 
 func (s *memSeeker) Close() {}
-func (s *memSeeker) Read(off, sz uint32) ([]byte, error) {
-	return s.data[off : off+sz], nil
+func (s *memSeeker) Read(off uint64, sz uint32) ([]byte, error) {
+	return s.data[off : off+uint64(sz)], nil
 }
 
-func (s *memSeeker) Size() (uint32, error) {
-	return uint32(len(s.data)), nil
+func (s *memSeeker) Size() (uint64, error) {
+	return uint64(len(s.data)), nil
 }
 
 func TestNewlines(t *testing.T) {
@@ -2062,7 +2062,7 @@ func TestListRepos(t *testing.T) {
 				Repos:                      1,
 				Shards:                     1,
 				Documents:                  4,
-				IndexBytes:                 412,
+				IndexBytes:                 460,
 				ContentBytes:               68,
 				NewLinesCount:              4,
 				DefaultBranchNewLinesCount: 2,
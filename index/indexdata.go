@@ -39,10 +39,10 @@ type indexData struct {
 
 	contentNgrams btreeIndex
 
-	newlinesStart uint32
+	newlinesStart uint64
 	newlinesIndex []uint32
 
-	docSectionsStart uint32
+	docSectionsStart uint64
 	docSectionsIndex []uint32
 
 	runeDocSections []DocumentSection
@@ -51,9 +51,13 @@ type indexData struct {
 	runeOffsets runeOffsetMap
 
 	// offsets of file contents; includes end of last file
-	boundariesStart uint32
+	boundariesStart uint64
 	boundaries      []uint32
 
+	// contentBlocks reads the file contents if they are stored in
+	// compressed blocks, or is nil.
+	contentBlocks *contentBlocks
+
 	// rune offsets for the file content boundaries
 	fileEndRunes []uint32
 
@@ -96,6 +100,29 @@ type indexData struct {
 	// file categories for all the files.
 	categories []byte
 
+	// commits holds the commit metadata of all documents, which is nil for
+	// files. It is nil if the shard contains no commits.
+	commits []*Commit
+
+	// blameCommits holds the commits referenced by the blame section, and
+	// blameStart and blameIndex locate the blame of each document. blameIndex
+	// is empty if the shard has no blame.
+	blameCommits []zoekt.Blame
+	blameStart   uint64
+	blameIndex   []uint32
+
+	// scipSymbols holds the symbols referenced by the occurrences section,
+	// and occurrencesStart and occurrencesIndex locate the occurrences of
+	// each document. occurrencesIndex is empty if the shard has no
+	// occurrences.
+	scipSymbols      []scipSymbol
+	occurrencesStart uint64
+	occurrencesIndex []uint32
+
+	// fingerprints holds the MinHash signatures of all documents, or is
+	// empty if the shard has none.
+	fingerprints []byte
+
 	repoListEntry []zoekt.RepoListEntry
 
 	// repository indexes for all the files
@@ -281,7 +308,7 @@ func (d *indexData) calculateNewLinesStats(start, end uint32) (count, defaultCou
 		// this is readNewlines but only reading the size of each section which
 		// corresponds to the number of newlines.
 		sec := simpleSection{
-			off: d.newlinesStart + d.newlinesIndex[i],
+			off: d.newlinesStart + uint64(d.newlinesIndex[i]),
 			sz:  d.newlinesIndex[i+1] - d.newlinesIndex[i],
 		}
 		// We are only reading the first varint which is the size. So we don't
@@ -396,6 +423,9 @@ type ngramIterationResults struct {
 	fileName      bool
 	substrBytes   []byte
 	substrLowered []byte
+
+	// explanation describes the ngrams that were chosen.
+	explanation zoekt.NgramExplanation
 }
 
 func (r *ngramIterationResults) String() string {
@@ -433,14 +463,17 @@ func (d *indexData) iterateNgrams(query *query.Substring) (*ngramIterationResult
 	ngrams := d.ngrams(query.FileName)
 	for i, o := range ngramOffs {
 		var freq uint32
-		if query.CaseSensitive {
-			freq = ngrams.Get(o.ngram).sz
-			ngramLookups++
-		} else {
-			for _, v := range generateCaseNgrams(o.ngram) {
-				freq += ngrams.Get(v).sz
-				ngramLookups++
+		variants := []ngram{o.ngram}
+		if !query.CaseSensitive {
+			variants = generateCaseNgrams(o.ngram)
+		}
+		for _, v := range variants {
+			sec, err := ngrams.Get(v)
+			if err != nil {
+				return nil, err
 			}
+			freq += sec.sz
+			ngramLookups++
 		}
 
 		if freq == 0 {
@@ -451,6 +484,7 @@ func (d *indexData) iterateNgrams(query *query.Substring) (*ngramIterationResult
 						NgramLookups: ngramLookups,
 					},
 				},
+				explanation: ngramExplanation(query, []ngram{o.ngram}, []uint32{0}),
 			}, nil
 		}
 
@@ -459,6 +493,10 @@ func (d *indexData) iterateNgrams(query *query.Substring) (*ngramIterationResult
 	}
 
 	first, last := findSelectiveNgrams(ngramOffs, indexMap, frequencies)
+	chosen, chosenFreqs := []ngram{first.ngram}, []uint32{frequencies[indexMap[first.index]]}
+	if first != last {
+		chosen, chosenFreqs = append(chosen, last.ngram), append(chosenFreqs, frequencies[indexMap[last.index]])
+	}
 
 	iter := &ngramDocIterator{
 		leftPad:      uint32(first.index),
@@ -496,9 +534,23 @@ func (d *indexData) iterateNgrams(query *query.Substring) (*ngramIterationResult
 		fileName:      query.FileName,
 		substrBytes:   patBytes,
 		substrLowered: lowerPatBytes,
+		explanation:   ngramExplanation(query, chosen, chosenFreqs),
 	}, nil
 }
 
+func ngramExplanation(q *query.Substring, ngrams []ngram, sizes []uint32) zoekt.NgramExplanation {
+	e := zoekt.NgramExplanation{
+		Pattern:          q.Pattern,
+		FileName:         q.FileName,
+		CaseSensitive:    q.CaseSensitive,
+		PostingListSizes: sizes,
+	}
+	for _, ng := range ngrams {
+		e.Ngrams = append(e.Ngrams, ng.String())
+	}
+	return e
+}
+
 func (d *indexData) fileName(i uint32) []byte {
 	return d.fileNameContent[d.fileNameIndex[i]:d.fileNameIndex[i+1]]
 }
@@ -508,6 +560,9 @@ func (d *indexData) numDocs() uint32 {
 }
 
 func (s *indexData) Close() {
+	if s.contentBlocks != nil {
+		s.contentBlocks.close()
+	}
 	s.file.Close()
 }
 
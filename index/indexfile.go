@@ -25,23 +25,24 @@ import (
 
 type mmapedIndexFile struct {
 	name string
-	size uint32
+	size uint64
 	hMap syscall.Handle
 	data []byte
 }
 
-func (f *mmapedIndexFile) Read(off, sz uint32) ([]byte, error) {
-	if off > off+sz || off+sz > uint32(len(f.data)) {
-		return nil, fmt.Errorf("out of bounds: %d, len %d, name %s", off+sz, len(f.data), f.name)
+func (f *mmapedIndexFile) Read(off uint64, sz uint32) ([]byte, error) {
+	end := off + uint64(sz)
+	if end < off || end > uint64(len(f.data)) {
+		return nil, fmt.Errorf("out of bounds: %d, len %d, name %s", end, len(f.data), f.name)
 	}
-	return f.data[off : off+sz], nil
+	return f.data[off:end], nil
 }
 
 func (f *mmapedIndexFile) Name() string {
 	return f.name
 }
 
-func (f *mmapedIndexFile) Size() (uint32, error) {
+func (f *mmapedIndexFile) Size() (uint64, error) {
 	return f.size, nil
 }
 
@@ -65,15 +66,15 @@ func NewIndexFile(f *os.File) (IndexFile, error) {
 	}
 
 	sz := fi.Size()
-	if sz >= math.MaxUint32 {
+	if sz > math.MaxInt {
 		return nil, fmt.Errorf("file %s too large: %d", f.Name(), sz)
 	}
 	r := &mmapedIndexFile{
 		name: f.Name(),
-		size: uint32(sz),
+		size: uint64(sz),
 	}
 
-	hMap, err := syscall.CreateFileMapping(syscall.Handle(f.Fd()), nil, syscall.PAGE_READONLY, 0, uint32(r.size), nil)
+	hMap, err := syscall.CreateFileMapping(syscall.Handle(f.Fd()), nil, syscall.PAGE_READONLY, uint32(r.size>>32), uint32(r.size), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func NewIndexFile(f *os.File) (IndexFile, error) {
 		return nil, err
 	}
 
-	r.data = (*[1 << 30]byte)(unsafe.Pointer(addr))[:r.size]
+	r.data = unsafe.Slice((*byte)(unsafe.Pointer(addr)), r.size)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,149 @@
+package index
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// An IndexFileBackend stores shards at URIs of a scheme, such as http://.
+// Plain paths and file:// URIs are local files, which are memory mapped.
+type IndexFileBackend interface {
+	// Open opens the shard at uri.
+	Open(uri string) (IndexFile, error)
+
+	// ReadFile returns the content of the file at uri, such as the ".meta"
+	// file of a shard. If the file doesn't exist, the error satisfies
+	// os.IsNotExist.
+	ReadFile(uri string) ([]byte, error)
+
+	// List returns the URIs of the shards in the directory at uri, mapped to
+	// the time the shard or its ".meta" file was last modified.
+	List(uri string) (map[string]time.Time, error)
+}
+
+var (
+	indexFileBackendsMu sync.RWMutex
+	indexFileBackends   = map[string]IndexFileBackend{
+		"file":  fileBackend{},
+		"http":  NewHTTPIndexFileBackend(nil),
+		"https": NewHTTPIndexFileBackend(nil),
+	}
+)
+
+// RegisterIndexFileBackend makes shards at URIs of scheme available through
+// b, replacing the backend previously registered for scheme.
+func RegisterIndexFileBackend(scheme string, b IndexFileBackend) {
+	indexFileBackendsMu.Lock()
+	defer indexFileBackendsMu.Unlock()
+	indexFileBackends[scheme] = b
+}
+
+// LocalPath returns the path of uri if it is a plain path or a file:// URI.
+func LocalPath(uri string) (string, bool) {
+	scheme, _, ok := strings.Cut(uri, "://")
+	if !ok {
+		return uri, true
+	}
+	if scheme != "file" {
+		return "", false
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", false
+	}
+	return filepath.FromSlash(u.Path), true
+}
+
+func indexFileBackend(uri string) (IndexFileBackend, error) {
+	scheme, _, ok := strings.Cut(uri, "://")
+	if !ok {
+		scheme = "file"
+	}
+
+	indexFileBackendsMu.RLock()
+	defer indexFileBackendsMu.RUnlock()
+	b, ok := indexFileBackends[scheme]
+	if !ok {
+		return nil, fmt.Errorf("no index file backend for scheme %q of %s", scheme, uri)
+	}
+	return b, nil
+}
+
+// OpenIndexFile opens the shard at uri with the backend registered for its
+// scheme.
+func OpenIndexFile(uri string) (IndexFile, error) {
+	b, err := indexFileBackend(uri)
+	if err != nil {
+		return nil, err
+	}
+	return b.Open(uri)
+}
+
+// ListIndexFiles lists the shards in the directory at uri with the backend
+// registered for its scheme. See IndexFileBackend.List.
+func ListIndexFiles(uri string) (map[string]time.Time, error) {
+	b, err := indexFileBackend(uri)
+	if err != nil {
+		return nil, err
+	}
+	return b.List(uri)
+}
+
+// readIndexFileSibling returns the content of the file at uri, which is
+// usually next to a shard opened with OpenIndexFile.
+func readIndexFileSibling(uri string) ([]byte, error) {
+	b, err := indexFileBackend(uri)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: uri, Err: fs.ErrNotExist}
+	}
+	return b.ReadFile(uri)
+}
+
+// fileBackend opens local shards.
+type fileBackend struct{}
+
+func (fileBackend) Open(uri string) (IndexFile, error) {
+	p, _ := LocalPath(uri)
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	return NewIndexFile(f)
+}
+
+func (fileBackend) ReadFile(uri string) ([]byte, error) {
+	p, _ := LocalPath(uri)
+	return os.ReadFile(p)
+}
+
+func (fileBackend) List(uri string) (map[string]time.Time, error) {
+	dir, _ := LocalPath(uri)
+	fs, err := filepath.Glob(filepath.Join(dir, "*.zoekt"))
+	if err != nil {
+		return nil, err
+	}
+
+	ts := make(map[string]time.Time, len(fs))
+	for _, fn := range fs {
+		fi, err := os.Lstat(fn)
+		if err != nil {
+			continue
+		}
+		ts[fn] = fi.ModTime()
+
+		fiMeta, err := os.Lstat(fn + ".meta")
+		if err != nil {
+			continue
+		}
+		if fiMeta.ModTime().After(fi.ModTime()) {
+			ts[fn] = fiMeta.ModTime()
+		}
+	}
+	return ts, nil
+}
@@ -0,0 +1,301 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The HTTP backend reads shards from HTTP servers that support range
+// requests, such as object stores or static file servers. Shards are read in
+// blocks of httpBlockSize bytes, which are kept in a cache shared by all
+// shards and bounded in bytes. A run of missing blocks is fetched with a
+// single range request.
+//
+// Directories are listed by the links to shards on the page served for the
+// directory, like the index pages of most static file servers. Modification
+// times come from the Last-Modified header of the shard and its ".meta" file,
+// which are requested for several shards at once.
+
+const (
+	httpBlockSize = 256 << 10
+
+	// defaultHTTPBlockCacheMB is the size of the HTTP block cache if the
+	// ZOEKT_HTTP_BLOCK_CACHE_MB environment variable isn't set.
+	defaultHTTPBlockCacheMB = 256
+
+	// defaultHTTPTimeout is the timeout of the client used if none is given
+	// to NewHTTPIndexFileBackend.
+	defaultHTTPTimeout = time.Minute
+
+	// httpListConcurrency bounds the HEAD requests sent at once to list a
+	// directory.
+	httpListConcurrency = 8
+)
+
+var httpBlockCache = newBlockCache[httpBlockKey](cacheBytesFromEnv("ZOEKT_HTTP_BLOCK_CACHE_MB", defaultHTTPBlockCacheMB))
+
+type httpBlockKey struct {
+	file  *httpIndexFile
+	block uint64
+}
+
+type httpBackend struct {
+	client *http.Client
+}
+
+// NewHTTPIndexFileBackend returns a backend for shards served over HTTP,
+// which sends requests with client. If client is nil, it uses a client with a
+// timeout of one minute per request. Use it with RegisterIndexFileBackend to
+// configure authentication or timeouts.
+func NewHTTPIndexFileBackend(client *http.Client) IndexFileBackend {
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+	return &httpBackend{client: client}
+}
+
+func (b *httpBackend) head(uri string) (*http.Response, error) {
+	resp, err := b.client.Head(uri)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &fs.PathError{Op: "open", Path: uri, Err: fs.ErrNotExist}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HEAD %s: %s", uri, resp.Status)
+	}
+	return resp, nil
+}
+
+func (b *httpBackend) Open(uri string) (IndexFile, error) {
+	resp, err := b.head(uri)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ContentLength < 0 {
+		return nil, fmt.Errorf("HEAD %s: unknown size", uri)
+	}
+
+	// If the shard is replaced while we read it, the validator makes range
+	// requests fail instead of returning parts of the new shard.
+	validator := resp.Header.Get("ETag")
+	if validator == "" {
+		validator = resp.Header.Get("Last-Modified")
+	}
+
+	return &httpIndexFile{
+		client:    b.client,
+		uri:       uri,
+		size:      uint64(resp.ContentLength),
+		validator: validator,
+	}, nil
+}
+
+func (b *httpBackend) ReadFile(uri string) ([]byte, error) {
+	resp, err := b.client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &fs.PathError{Op: "open", Path: uri, Err: fs.ErrNotExist}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", uri, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+var hrefRegexp = regexp.MustCompile(`(?i)href="([^"]+)"`)
+
+// List returns an error if a shard can't be checked, so that callers keep the
+// shards they know of instead of dropping them. Shards that were deleted after
+// the listing was served are left out.
+func (b *httpBackend) List(uri string) (map[string]time.Time, error) {
+	if !strings.HasSuffix(uri, "/") {
+		uri += "/"
+	}
+	base, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := b.ReadFile(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var shards []string
+	seen := map[string]bool{}
+	for _, m := range hrefRegexp.FindAllSubmatch(page, -1) {
+		ref, err := url.Parse(string(m[1]))
+		if err != nil || !strings.HasSuffix(ref.Path, ".zoekt") {
+			continue
+		}
+		shard := base.ResolveReference(ref).String()
+		if !seen[shard] {
+			seen[shard] = true
+			shards = append(shards, shard)
+		}
+	}
+
+	mtimes := make([]time.Time, len(shards))
+	errs := make([]error, len(shards))
+	sem := make(chan struct{}, httpListConcurrency)
+	var wg sync.WaitGroup
+	for i, shard := range shards {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			mtimes[i], errs[i] = b.modTime(shard)
+		}()
+	}
+	wg.Wait()
+
+	ts := map[string]time.Time{}
+	for i, shard := range shards {
+		if errors.Is(errs[i], fs.ErrNotExist) {
+			continue
+		}
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		ts[shard] = mtimes[i]
+	}
+	return ts, nil
+}
+
+// modTime returns the time shard or its ".meta" file was last modified.
+func (b *httpBackend) modTime(shard string) (time.Time, error) {
+	resp, err := b.head(shard)
+	if err != nil {
+		return time.Time{}, err
+	}
+	mtime, _ := http.ParseTime(resp.Header.Get("Last-Modified"))
+
+	resp, err = b.head(shard + ".meta")
+	if errors.Is(err, fs.ErrNotExist) {
+		return mtime, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if metaTime, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil && metaTime.After(mtime) {
+		mtime = metaTime
+	}
+	return mtime, nil
+}
+
+// httpIndexFile reads a shard with HTTP range requests.
+type httpIndexFile struct {
+	client    *http.Client
+	uri       string
+	size      uint64
+	validator string
+}
+
+func (f *httpIndexFile) Read(off uint64, sz uint32) ([]byte, error) {
+	end := off + uint64(sz)
+	if end < off || end > f.size {
+		return nil, fmt.Errorf("out of bounds: %d, len %d, name %s", end, f.size, f.uri)
+	}
+	if sz == 0 {
+		return []byte{}, nil
+	}
+
+	first, last := off/httpBlockSize, (end-1)/httpBlockSize
+	blocks := make([][]byte, last-first+1)
+	for i := first; i <= last; {
+		if data, ok := httpBlockCache.get(httpBlockKey{file: f, block: i}); ok {
+			blocks[i-first] = data
+			i++
+			continue
+		}
+
+		j := i
+		for j < last {
+			if _, ok := httpBlockCache.get(httpBlockKey{file: f, block: j + 1}); ok {
+				break
+			}
+			j++
+		}
+		if err := f.fetch(i, j, blocks[i-first:j-first+1]); err != nil {
+			return nil, err
+		}
+		i = j + 1
+	}
+
+	start := off - first*httpBlockSize
+	if first == last {
+		return blocks[0][start : start+uint64(sz)], nil
+	}
+
+	out := make([]byte, 0, sz)
+	for i, data := range blocks {
+		if i == 0 {
+			data = data[start:]
+		}
+		out = append(out, data[:min(len(data), int(sz)-len(out))]...)
+	}
+	return out, nil
+}
+
+// fetch reads blocks first to last into blocks and adds them to the cache.
+func (f *httpIndexFile) fetch(first, last uint64, blocks [][]byte) error {
+	start, stop := first*httpBlockSize, min((last+1)*httpBlockSize, f.size)
+
+	req, err := http.NewRequest(http.MethodGet, f.uri, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, stop-1))
+	if f.validator != "" {
+		req.Header.Set("If-Range", f.validator)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		return fmt.Errorf("GET %s bytes %d-%d: got %s, want %s; the shard changed or the server doesn't support range requests",
+			f.uri, start, stop-1, resp.Status, http.StatusText(http.StatusPartialContent))
+	}
+
+	for i := range blocks {
+		blockStart := start + uint64(i)*httpBlockSize
+		data := make([]byte, min(httpBlockSize, stop-blockStart))
+		if _, err := io.ReadFull(resp.Body, data); err != nil {
+			return fmt.Errorf("GET %s bytes %d-%d: %w", f.uri, start, stop-1, err)
+		}
+		blocks[i] = data
+		httpBlockCache.add(httpBlockKey{file: f, block: first + uint64(i)}, data)
+	}
+	return nil
+}
+
+func (f *httpIndexFile) Name() string {
+	return f.uri
+}
+
+func (f *httpIndexFile) Size() (uint64, error) {
+	return f.size, nil
+}
+
+// Close drops the blocks of f from the cache.
+func (f *httpIndexFile) Close() {
+	httpBlockCache.purge(func(k httpBlockKey) bool { return k.file == f })
+}
@@ -0,0 +1,242 @@
+package index
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// fileServer serves dir and counts the range requests it gets.
+func fileServer(t *testing.T, dir string) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var rangeRequests atomic.Int64
+	fs := http.FileServer(http.Dir(dir))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			rangeRequests.Add(1)
+		}
+		fs.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &rangeRequests
+}
+
+func TestHTTPIndexFileRead(t *testing.T) {
+	dir := t.TempDir()
+	data := make([]byte, 3*httpBlockSize+100)
+	rand.New(rand.NewSource(1)).Read(data)
+	if err := os.WriteFile(filepath.Join(dir, "blob"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	srv, rangeRequests := fileServer(t, dir)
+
+	f, err := OpenIndexFile(srv.URL + "/blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if sz, err := f.Size(); err != nil || sz != uint64(len(data)) {
+		t.Fatalf("got size %d, %v, want %d", sz, err, len(data))
+	}
+
+	for _, tc := range []struct {
+		off uint64
+		sz  uint32
+	}{
+		{0, 0},
+		{10, 20},
+		{httpBlockSize - 10, 20},
+		{0, uint32(len(data))},
+		{2*httpBlockSize + 5, httpBlockSize + 95},
+	} {
+		got, err := f.Read(tc.off, tc.sz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data[tc.off:tc.off+uint64(tc.sz)]) {
+			t.Errorf("Read(%d, %d): got different data", tc.off, tc.sz)
+		}
+	}
+
+	// The first two reads fetch block 0 and block 1, the third the blocks 2
+	// and 3 in one request. Everything else is cached.
+	if got := rangeRequests.Load(); got != 3 {
+		t.Errorf("got %d range requests, want 3", got)
+	}
+
+	if _, err := f.Read(uint64(len(data))-10, 20); err == nil {
+		t.Error("got no error reading past the end")
+	}
+
+	// A shard replaced while it is open isn't read any further.
+	f.Close()
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "blob"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Read(0, 10); err == nil {
+		t.Error("got no error reading a changed file")
+	}
+}
+
+func TestHTTPIndexFileShard(t *testing.T) {
+	dir := t.TempDir()
+	b := testShardBuilder(t, &zoekt.Repository{Name: "repo"},
+		Document{Name: "f1", Content: []byte("to carry water in the no later bla")},
+		Document{Name: "f2", Content: []byte("bla the needle")})
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	shard := filepath.Join(dir, "repo_v16.00000.zoekt")
+	if err := os.WriteFile(shard, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	srv, _ := fileServer(t, dir)
+
+	ts, err := ListIndexFiles(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uri := srv.URL + "/repo_v16.00000.zoekt"
+	if _, ok := ts[uri]; len(ts) != 1 || !ok {
+		t.Fatalf("got shards %v, want %s", ts, uri)
+	}
+
+	// The .meta file overrides the repository metadata, and its
+	// modification time counts as that of the shard.
+	if err := os.WriteFile(shard+".meta", []byte(`{"Name": "renamed"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(shard+".meta", later, later); err != nil {
+		t.Fatal(err)
+	}
+	ts, err = ListIndexFiles(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ts[uri]; !got.Equal(later) {
+		t.Errorf("got modification time %v, want %v", got, later)
+	}
+
+	f, err := OpenIndexFile(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSearcher(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	res, err := s.Search(context.Background(), &query.Substring{Pattern: "needle"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, fm := range res.Files {
+		got = append(got, fm.Repository+"/"+fm.FileName)
+	}
+	if diff := cmp.Diff([]string{"renamed/f2"}, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := readIndexFileSibling(srv.URL + "/missing.meta"); !os.IsNotExist(err) {
+		t.Errorf("got %v, want a not exist error", err)
+	}
+}
+
+func TestHTTPBackendList(t *testing.T) {
+	var (
+		mu                 sync.Mutex
+		inflight, maxHeads int
+		failing            atomic.Bool
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		for i := range 20 {
+			fmt.Fprintf(w, "<a href=\"repo%d_v16.00000.zoekt\">repo%d</a>\n", i, i)
+		}
+		fmt.Fprintf(w, "<a href=\"gone_v16.00000.zoekt\">gone</a>\n")
+	})
+	mux.HandleFunc("/gone_v16.00000.zoekt", http.NotFound)
+	mux.HandleFunc("/{shard}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inflight++
+		maxHeads = max(maxHeads, inflight)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inflight--
+		mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, ".meta") {
+			http.NotFound(w, r)
+			return
+		}
+		if failing.Load() && r.URL.Path == "/repo3_v16.00000.zoekt" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Last-Modified", time.Unix(1e9, 0).UTC().Format(http.TimeFormat))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	b := NewHTTPIndexFileBackend(nil)
+	ts, err := b.List(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ts) != 20 {
+		t.Errorf("got %d shards, want 20", len(ts))
+	}
+	if got := ts[srv.URL+"/repo3_v16.00000.zoekt"]; !got.Equal(time.Unix(1e9, 0)) {
+		t.Errorf("got modification time %v", got)
+	}
+	if maxHeads <= 1 || maxHeads > httpListConcurrency {
+		t.Errorf("got %d concurrent HEAD requests, want between 2 and %d", maxHeads, httpListConcurrency)
+	}
+
+	// A shard that can't be checked fails the listing instead of being
+	// dropped.
+	failing.Store(true)
+	if ts, err := b.List(srv.URL); err == nil {
+		t.Errorf("got shards %v, want an error", ts)
+	}
+}
+
+func TestOpenIndexFileScheme(t *testing.T) {
+	if _, err := OpenIndexFile("s3://bucket/shard.zoekt"); err == nil {
+		t.Error("got no error for unregistered scheme")
+	}
+
+	for uri, want := range map[string]string{
+		"/data/index":        "/data/index",
+		"index":              "index",
+		"file:///data/index": filepath.FromSlash("/data/index"),
+		"http://host/index":  "",
+	} {
+		got, _ := LocalPath(uri)
+		if got != want {
+			t.Errorf("LocalPath(%q): got %q, want %q", uri, got, want)
+		}
+	}
+}
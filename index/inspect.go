@@ -0,0 +1,229 @@
+package index
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// ShardInfo describes the internals of a shard, for debugging index size
+// and ranking issues.
+type ShardInfo struct {
+	// Size is the size of the shard file in bytes.
+	Size uint64
+
+	Metadata     zoekt.IndexMetadata
+	Repositories []zoekt.Repository
+
+	// Sections lists the sections of the shard in TOC order.
+	Sections []SectionInfo
+
+	// Documents is only set if InspectOptions.Documents is set.
+	Documents []DocumentInfo `json:",omitempty"`
+
+	ContentNgrams  int
+	FileNameNgrams int
+
+	// LargestPostingLists holds the content ngrams with the largest
+	// posting lists in bytes, and CommonNgrams the ones that occur most
+	// often.
+	LargestPostingLists []NgramInfo
+	CommonNgrams        []NgramInfo
+
+	// Symbols is the number of symbols by kind. Symbols without a kind are
+	// counted under "".
+	Symbols map[string]int
+}
+
+// SectionInfo describes a section of a shard.
+type SectionInfo struct {
+	Name   string
+	Kind   string
+	Offset uint64
+	// Size is the size of the section in bytes, including the index of
+	// compound sections.
+	Size uint64
+	// Items is the number of items of compound sections.
+	Items int `json:",omitempty"`
+}
+
+// DocumentInfo describes a document of a shard.
+type DocumentInfo struct {
+	Name       string
+	Repository string
+	Size       uint32
+	Language   string `json:",omitempty"`
+	Category   string
+	BranchMask uint64
+	Branches   []string
+	Symbols    int
+	// Tombstoned is set if the repository or the file is tombstoned.
+	Tombstoned bool `json:",omitempty"`
+}
+
+// NgramInfo describes the posting list of an ngram.
+type NgramInfo struct {
+	Ngram string
+	// Bytes is the size of the posting list.
+	Bytes uint32
+	// Occurrences is the number of times the ngram occurs in the content.
+	Occurrences int
+}
+
+// InspectOptions configures InspectShard.
+type InspectOptions struct {
+	// Documents includes the list of documents.
+	Documents bool
+
+	// TopNgrams is the number of ngrams in ShardInfo.LargestPostingLists
+	// and ShardInfo.CommonNgrams.
+	TopNgrams int
+}
+
+// InspectShard loads the shard in f and describes its internals. It reads
+// all content posting lists, so it is slow for large shards. The IndexFile
+// is not closed.
+func InspectShard(f IndexFile, opts InspectOptions) (*ShardInfo, error) {
+	rd := &reader{r: f}
+	var toc indexTOC
+	if err := rd.readTOC(&toc); err != nil {
+		return nil, err
+	}
+	d, err := rd.readIndexData(&toc)
+	if err != nil {
+		return nil, err
+	}
+	// The IndexFile belongs to the caller, so only drop the cached blocks.
+	if d.contentBlocks != nil {
+		defer d.contentBlocks.close()
+	}
+
+	size, err := f.Size()
+	if err != nil {
+		return nil, err
+	}
+	info := &ShardInfo{
+		Size:         size,
+		Metadata:     d.metaData,
+		Repositories: d.repoMetaData,
+		Sections:     inspectSections(&toc),
+		Symbols:      map[string]int{},
+	}
+
+	for i := uint32(0); i < uint32(len(d.symbols.symMetaData))/(4*4); i++ {
+		info.Symbols[d.symbols.data(i).Kind]++
+	}
+
+	if opts.Documents {
+		info.Documents = d.inspectDocuments()
+	}
+
+	ngrams, err := d.inspectNgrams()
+	if err != nil {
+		return nil, err
+	}
+	info.ContentNgrams = len(ngrams)
+	info.FileNameNgrams = len(d.fileNameNgrams.DumpMap())
+	info.LargestPostingLists = topNgrams(ngrams, opts.TopNgrams, func(a, b NgramInfo) int {
+		return cmp.Compare(b.Bytes, a.Bytes)
+	})
+	info.CommonNgrams = topNgrams(ngrams, opts.TopNgrams, func(a, b NgramInfo) int {
+		return cmp.Compare(b.Occurrences, a.Occurrences)
+	})
+	return info, nil
+}
+
+func inspectSections(toc *indexTOC) []SectionInfo {
+	var secs []SectionInfo
+	for _, ts := range toc.sectionsTaggedList() {
+		s := SectionInfo{Name: ts.tag}
+		switch sec := ts.sec.(type) {
+		case *simpleSection:
+			s.Kind = "simple"
+		case *compoundSection:
+			s.Kind = "compound"
+			s.Items = int(sec.index.sz / 4)
+		case *lazyCompoundSection:
+			s.Kind = "compound-lazy"
+			s.Items = int(sec.index.sz / 4)
+		}
+		for i, p := range sectionParts(ts.sec) {
+			if i == 0 || (p.sz > 0 && p.off < s.Offset) {
+				s.Offset = p.off
+			}
+			s.Size += uint64(p.sz)
+		}
+		secs = append(secs, s)
+	}
+	return secs
+}
+
+func (d *indexData) inspectDocuments() []DocumentInfo {
+	docs := make([]DocumentInfo, 0, len(d.fileBranchMasks))
+	for i := range uint32(len(d.fileBranchMasks)) {
+		repo := &d.repoMetaData[d.repos[i]]
+		name := string(d.fileNameContent[d.fileNameIndex[i]:d.fileNameIndex[i+1]])
+		_, fileTombstone := repo.FileTombstones[name]
+
+		doc := DocumentInfo{
+			Name:       name,
+			Repository: repo.Name,
+			Size:       d.boundaries[i+1] - d.boundaries[i],
+			Language:   d.languageMap[d.getLanguage(i)],
+			Category:   d.getCategory(i).String(),
+			BranchMask: d.fileBranchMasks[i],
+			Tombstoned: repo.Tombstone || fileTombstone,
+		}
+		for mask := d.fileBranchMasks[i]; mask != 0; mask &= mask - 1 {
+			bit := uint(1) << bits.TrailingZeros64(mask)
+			doc.Branches = append(doc.Branches, d.branchNames[d.repos[i]][bit])
+		}
+		if int(i+1) < len(d.fileEndSymbol) {
+			doc.Symbols = int(d.fileEndSymbol[i+1] - d.fileEndSymbol[i])
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// inspectNgrams returns the posting lists of all content ngrams.
+func (d *indexData) inspectNgrams() ([]NgramInfo, error) {
+	m := d.contentNgrams.DumpMap()
+	ngrams := make([]NgramInfo, 0, len(m))
+	for ng, sec := range m {
+		postings, err := d.readSectionBlob(sec)
+		if err != nil {
+			return nil, fmt.Errorf("posting list of %q: %w", ng, err)
+		}
+		// Posting lists are varint encoded deltas, so each byte without
+		// the continuation bit ends an occurrence.
+		occurrences := 0
+		for _, b := range postings {
+			if b < 0x80 {
+				occurrences++
+			}
+		}
+		ngrams = append(ngrams, NgramInfo{
+			Ngram:       ng.String(),
+			Bytes:       sec.sz,
+			Occurrences: occurrences,
+		})
+	}
+	return ngrams, nil
+}
+
+// topNgrams returns the first n ngrams ordered by cmpFunc, breaking ties by
+// ngram.
+func topNgrams(ngrams []NgramInfo, n int, cmpFunc func(a, b NgramInfo) int) []NgramInfo {
+	sorted := slices.Clone(ngrams)
+	slices.SortFunc(sorted, func(a, b NgramInfo) int {
+		if c := cmpFunc(a, b); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Ngram, b.Ngram)
+	})
+	return sorted[:min(n, len(sorted))]
+}
@@ -0,0 +1,106 @@
+package index
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+func TestInspectShard(t *testing.T) {
+	repo := &zoekt.Repository{
+		Name: "repo",
+		Branches: []zoekt.RepositoryBranch{
+			{Name: "main", Version: "v1"},
+			{Name: "dev", Version: "v2"},
+		},
+		FileTombstones: map[string]struct{}{"old.txt": {}},
+	}
+	b := testShardBuilder(t, repo,
+		Document{
+			Name:     "main.go",
+			Content:  []byte("package main\n\nfunc aaaa() {}\n"),
+			Branches: []string{"main", "dev"},
+			Language: "Go",
+			Symbols:  []DocumentSection{{Start: 8, End: 12}, {Start: 19, End: 23}},
+			SymbolsMetaData: []*zoekt.Symbol{
+				{Kind: "package"},
+				{Kind: "func"},
+			},
+		},
+		Document{
+			Name:     "old.txt",
+			Content:  []byte("aaaaaa\n"),
+			Branches: []string{"dev"},
+		})
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := InspectShard(&memSeeker{buf.Bytes()}, InspectOptions{Documents: true, TopNgrams: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Size != uint64(buf.Len()) {
+		t.Errorf("got size %d, want %d", info.Size, buf.Len())
+	}
+	if len(info.Repositories) != 1 || info.Repositories[0].Name != "repo" {
+		t.Errorf("got repositories %v", info.Repositories)
+	}
+
+	wantDocs := []DocumentInfo{
+		{
+			Name:       "main.go",
+			Repository: "repo",
+			Size:       29,
+			Language:   "Go",
+			Category:   "default",
+			BranchMask: 3,
+			Branches:   []string{"main", "dev"},
+			Symbols:    2,
+		},
+		{
+			Name:       "old.txt",
+			Repository: "repo",
+			Size:       7,
+			Language:   "Text",
+			Category:   "default",
+			BranchMask: 2,
+			Branches:   []string{"dev"},
+			Tombstoned: true,
+		},
+	}
+	if diff := cmp.Diff(wantDocs, info.Documents); diff != "" {
+		t.Errorf("documents mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string]int{"package": 1, "func": 1}, info.Symbols); diff != "" {
+		t.Errorf("symbols mismatch (-want +got):\n%s", diff)
+	}
+
+	// "aaa" occurs twice in main.go and four times in old.txt.
+	wantCommon := []NgramInfo{{Ngram: "aaa", Bytes: 6, Occurrences: 6}}
+	if diff := cmp.Diff(wantCommon, info.CommonNgrams[:1]); diff != "" {
+		t.Errorf("common ngrams mismatch (-want +got):\n%s", diff)
+	}
+	if got := len(info.LargestPostingLists); got != 2 {
+		t.Errorf("got %d largest posting lists, want 2", got)
+	}
+
+	var sawContent bool
+	for _, s := range info.Sections {
+		if s.Name == "fileContents" {
+			sawContent = true
+			if s.Kind != "compound" || s.Items != 2 {
+				t.Errorf("got fileContents section %+v, want a compound section with 2 items", s)
+			}
+		}
+	}
+	if !sawContent {
+		t.Error("fileContents section missing")
+	}
+}
@@ -10,7 +10,11 @@ import (
 // DisplayTruncator. Given an aggregated files it will sort and then truncate
 // based on the search options.
 func SortAndTruncateFiles(files []zoekt.FileMatch, opts *zoekt.SearchOptions) []zoekt.FileMatch {
-	SortFiles(files)
+	if opts.Paginated() {
+		SortFilesForPagination(files)
+	} else {
+		SortFiles(files)
+	}
 	truncator, _ := NewDisplayTruncator(opts)
 	files, _ = truncator(files)
 	return files
@@ -53,7 +57,14 @@ func NewDisplayTruncator(opts *zoekt.SearchOptions) (_ DisplayTruncator, hasLimi
 			docLimit -= len(fm)
 		}
 
-		if matchLimited {
+		if matchLimited && opts.Paginated() {
+			// A cursor can't point into a file, so pages end after the file
+			// reaching the limit instead of cutting off its matches.
+			fm, matchLimit = limitFilesByMatches(fm, matchLimit, opts.ChunkMatches)
+			if matchLimit <= 0 {
+				done = true
+			}
+		} else if matchLimited {
 			fm, matchLimit = limitMatches(fm, matchLimit, opts.ChunkMatches)
 			if matchLimit <= 0 {
 				done = true
@@ -80,6 +91,24 @@ func limitMatches(files []zoekt.FileMatch, limit int, chunkMatches bool) ([]zoek
 	return files, limit
 }
 
+// limitFilesByMatches keeps the files up to the one whose matches reach
+// limit, with all their matches. It returns the remaining limit, if any.
+func limitFilesByMatches(files []zoekt.FileMatch, limit int, chunkMatches bool) ([]zoekt.FileMatch, int) {
+	for i := range files {
+		if chunkMatches {
+			for _, cm := range files[i].ChunkMatches {
+				limit -= len(cm.Ranges)
+			}
+		} else {
+			limit -= len(files[i].LineMatches)
+		}
+		if limit <= 0 {
+			return files[:i+1], 0
+		}
+	}
+	return files, limit
+}
+
 // Limit the number of ChunkMatches in the given FileMatch, returning the
 // remaining limit, if any.
 func limitChunkMatches(file *zoekt.FileMatch, limit int) int {
@@ -228,6 +228,19 @@ type wordMatchTree struct {
 	bruteForceMatchTree
 }
 
+// structuralMatchTree matches a comby-style structural pattern against the
+// content of a document.
+type structuralMatchTree struct {
+	pattern *structuralPattern
+
+	// mutable
+	evaluated bool
+	found     []*candidateMatch
+
+	// nextDoc, prepare.
+	bruteForceMatchTree
+}
+
 type substrMatchTree struct {
 	matchIterator
 
@@ -404,6 +417,12 @@ func (t *wordMatchTree) prepare(doc uint32) {
 	t.bruteForceMatchTree.prepare(doc)
 }
 
+func (t *structuralMatchTree) prepare(doc uint32) {
+	t.found = t.found[:0]
+	t.evaluated = false
+	t.bruteForceMatchTree.prepare(doc)
+}
+
 func (t *orMatchTree) prepare(doc uint32) {
 	for _, c := range t.children {
 		c.prepare(doc)
@@ -525,6 +544,10 @@ func (t *regexpMatchTree) String() string {
 	return fmt.Sprintf("%sre(%s)", f, t.regexp)
 }
 
+func (t *structuralMatchTree) String() string {
+	return fmt.Sprintf("struct(%q)", t.pattern.source)
+}
+
 func (t *wordMatchTree) String() string {
 	f := ""
 	if t.fileName {
@@ -588,6 +611,8 @@ func visitMatchTree(t matchTree, f func(matchTree)) {
 		}
 	case *andLineMatchTree:
 		visitMatchTree(&s.andMatchTree, f)
+	case *nearMatchTree:
+		visitMatchTree(&s.andMatchTree, f)
 	case *noVisitMatchTree:
 		visitMatchTree(s.matchTree, f)
 	case *notMatchTree:
@@ -615,6 +640,21 @@ func updateMatchTreeStats(mt matchTree, stats *zoekt.Stats) {
 	})
 }
 
+// explainNgrams returns the ngrams chosen by the substring atoms of mt.
+func explainNgrams(mt matchTree) []zoekt.NgramExplanation {
+	var ngrams []zoekt.NgramExplanation
+	visitMatchTree(mt, func(mt matchTree) {
+		st, ok := mt.(*substrMatchTree)
+		if !ok {
+			return
+		}
+		if r, ok := st.matchIterator.(*ngramIterationResults); ok {
+			ngrams = append(ngrams, r.explanation)
+		}
+	})
+	return ngrams
+}
+
 func visitMatchAtoms(t matchTree, known map[matchTree]bool, f func(matchTree)) {
 	visitMatches(t, known, 1, func(mt matchTree, _ float64) {
 		f(mt)
@@ -858,6 +898,28 @@ func (t *wordMatchTree) matches(cp *contentProvider, cost int, known map[matchTr
 	return matchesStateForSlice(t.found)
 }
 
+func (t *structuralMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) matchesState {
+	if t.evaluated {
+		return matchesStateForSlice(t.found)
+	}
+
+	if cost < costRegexp {
+		return matchesRequiresHigherCost
+	}
+
+	found := t.found[:0]
+	for _, m := range t.pattern.findAll(cp.data(false)) {
+		found = append(found, &candidateMatch{
+			byteOffset:  uint32(m[0]),
+			byteMatchSz: uint32(m[1] - m[0]),
+		})
+	}
+	t.found = found
+	t.evaluated = true
+
+	return matchesStateForSlice(t.found)
+}
+
 // breakMatchesOnNewlines returns matches resulting from breaking each element
 // of cms on newlines within text.
 func breakMatchesOnNewlines(cms []*candidateMatch, text []byte) []*candidateMatch {
@@ -1014,6 +1076,16 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 			r = append(r, ct)
 		}
 		return &andMatchTree{r}, nil
+	case *query.Near:
+		var r []matchTree
+		for _, ch := range s.Children {
+			ct, err := d.newMatchTree(ch, opt)
+			if err != nil {
+				return nil, err
+			}
+			r = append(r, ct)
+		}
+		return &nearMatchTree{andMatchTree: andMatchTree{r}, distance: s.Distance}, nil
 	case *query.Or:
 		var r []matchTree
 		for _, ch := range s.Children {
@@ -1031,6 +1103,14 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 		}, err
 
 	case *query.Type:
+		if s.Type == query.TypeCommit {
+			ct, err := d.newMatchTree(s.Child, opt)
+			if err != nil {
+				return nil, err
+			}
+			return &andMatchTree{[]matchTree{d.commitDocMatchTree(true), ct}}, nil
+		}
+
 		if s.Type != query.TypeFileName {
 			break
 		}
@@ -1088,6 +1168,27 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 	case *query.Substring:
 		return d.newSubstringMatchTree(s)
 
+	case *query.Author, *query.CommitDate, *query.Message:
+		return d.newCommitMatchTree(s)
+
+	case *query.Occurrence:
+		return d.newOccurrenceMatchTree(s), nil
+
+	case *query.SymbolRefs:
+		return d.newSymbolRefsMatchTree(s)
+
+	case *query.Structural:
+		return d.newStructuralMatchTree(s)
+
+	case *query.Identifier:
+		return d.newMatchTree(identifierQuery(s), opt)
+
+	case *query.FuzzyFileName:
+		return d.newFuzzyFileNameMatchTree(s)
+
+	case *query.Modified:
+		return d.newModifiedMatchTree(s), nil
+
 	case *query.Branch:
 		masks := make([]uint64, 0, len(d.repoMetaData))
 		if s.Pattern == "HEAD" {
@@ -1129,6 +1230,19 @@ func (d *indexData) newMatchTree(q query.Q, opt matchTreeOpt) (matchTree, error)
 			},
 		}, nil
 
+	case *query.Category:
+		category, ok := parseFileCategory(s.Category)
+		if !ok {
+			return &noMatchTree{Why: "category"}, nil
+		}
+		return &docMatchTree{
+			reason:  "category",
+			numDocs: d.numDocs(),
+			predicate: func(docID uint32) bool {
+				return d.getCategory(docID) == category
+			},
+		}, nil
+
 	case *query.Symbol:
 		// Disable WordMatchTree since we don't support it in symbols yet.
 		optCopy := opt
@@ -1292,6 +1406,32 @@ func (d *indexData) newSubstringMatchTree(s *query.Substring) (matchTree, error)
 	return st, nil
 }
 
+// newStructuralMatchTree returns a matchTree for a structural pattern. The
+// literal parts of the pattern narrow down the candidate documents using the
+// ngram index.
+func (d *indexData) newStructuralMatchTree(q *query.Structural) (matchTree, error) {
+	pattern, err := parseStructuralPattern(q.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []matchTree{&structuralMatchTree{pattern: pattern}}
+	for _, lit := range pattern.literals() {
+		if utf8.RuneCountInString(lit) < ngramSize {
+			continue
+		}
+		subMT, err := d.newSubstringMatchTree(&query.Substring{Pattern: lit, CaseSensitive: true, Content: true})
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, &noVisitMatchTree{subMT})
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return &andMatchTree{children: children}, nil
+}
+
 func regexpToWordMatchTree(q *query.Regexp, opt matchTreeOpt) (_ *wordMatchTree, ok bool) {
 	if opt.DisableWordMatchOptimization {
 		return nil, false
@@ -1401,6 +1541,15 @@ func pruneMatchTree(mt matchTree) (matchTree, error) {
 			// so the linematch portion is irrelevant.
 			return mt, nil
 		}
+	case *nearMatchTree:
+		// Like an and, any impossible child makes the near impossible.
+		child, err := pruneMatchTree(&mt.andMatchTree)
+		if err != nil {
+			return nil, err
+		}
+		if child == nil {
+			return nil, nil
+		}
 	case *notMatchTree:
 		mt.child, err = pruneMatchTree(mt.child)
 		if err != nil {
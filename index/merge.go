@@ -2,6 +2,7 @@ package index
 
 import (
 	"crypto/sha1"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"log"
@@ -50,6 +51,90 @@ func Merge(dstDir string, files ...IndexFile) (tmpName, dstName string, _ error)
 	return tmpName, dstName, nil
 }
 
+// maxMergedSectionSize bounds the estimated size of each section of a
+// compound shard. Sections, and the uncompressed content, hold at most 4 GiB.
+// The margin covers sections that grow a little when they are merged, like
+// the posting lists.
+const maxMergedSectionSize = 3 << 30
+
+// MergeGroups splits files into consecutive groups that Merge can each turn
+// into a compound shard. The size of a section of a compound shard is
+// estimated as the sum of the sizes of that section in the input shards.
+// Shards that are too large to be merged with others are in a group of their
+// own.
+func MergeGroups(files []IndexFile) ([][]IndexFile, error) {
+	return mergeGroups(files, maxMergedSectionSize)
+}
+
+func mergeGroups(files []IndexFile, limit uint64) ([][]IndexFile, error) {
+	var (
+		groups [][]IndexFile
+		group  []IndexFile
+		total  map[string]uint64
+	)
+	for _, f := range files {
+		sizes, err := mergeSizes(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name(), err)
+		}
+
+		fits := true
+		for k, sz := range sizes {
+			if total[k]+sz > limit {
+				fits = false
+				break
+			}
+		}
+		if !fits && len(group) > 0 {
+			groups = append(groups, group)
+			group, total = nil, nil
+		}
+		if total == nil {
+			total = map[string]uint64{}
+		}
+		for k, sz := range sizes {
+			total[k] += sz
+		}
+		group = append(group, f)
+	}
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// mergeSizes returns the sizes of the sections of f by tag, plus the size of
+// its uncompressed content as "content".
+func mergeSizes(f IndexFile) (map[string]uint64, error) {
+	r := reader{r: f}
+	var toc indexTOC
+	if err := r.readTOC(&toc); err != nil {
+		return nil, err
+	}
+
+	sizes := map[string]uint64{}
+	for _, s := range toc.sectionsTaggedList() {
+		switch sec := s.sec.(type) {
+		case *simpleSection:
+			sizes[s.tag] = uint64(sec.sz)
+		case *compoundSection:
+			sizes[s.tag] = uint64(sec.data.sz)
+		case *lazyCompoundSection:
+			sizes[s.tag] = uint64(sec.data.sz)
+		}
+	}
+
+	sizes["content"] = uint64(toc.fileContents.data.sz)
+	if b := toc.contentBoundaries; b.sz >= 4 {
+		end, err := f.Read(b.off+uint64(b.sz)-4, 4)
+		if err != nil {
+			return nil, err
+		}
+		sizes["content"] = uint64(binary.BigEndian.Uint32(end))
+	}
+	return sizes, nil
+}
+
 func builderWriteAll(fn string, ib *ShardBuilder) error {
 	dir := filepath.Dir(fn)
 	if err := os.MkdirAll(dir, 0o700); err != nil {
@@ -100,6 +185,9 @@ func merge(ds ...*indexData) (*ShardBuilder, error) {
 
 	sb := newShardBuilder()
 	sb.indexFormatVersion = NextIndexFormatVersion
+	for _, d := range ds {
+		sb.compressContent = sb.compressContent || d.contentBlocks != nil
+	}
 
 	for _, d := range ds {
 		lastRepoID := -1
@@ -248,6 +336,7 @@ func explode(dstDir string, f IndexFile, ibFuncs ...shardBuilderFunc) (map[strin
 
 			sb = newShardBuilder()
 			sb.indexFormatVersion = IndexFormatVersion
+			sb.compressContent = d.contentBlocks != nil
 			if err := sb.setRepository(&d.repoMetaData[repoID]); err != nil {
 				return shardNames, err
 			}
@@ -277,6 +366,9 @@ func addDocument(d *indexData, ib *ShardBuilder, repoID int, docID uint32) error
 		Language:          d.languageMap[d.getLanguage(docID)],
 		// SkipReason not set, will be part of content from original indexer.
 	}
+	if d.commits != nil {
+		doc.Commit = d.commits[docID]
+	}
 
 	var err error
 	if doc.Content, err = d.readContents(docID); err != nil {
@@ -287,6 +379,14 @@ func addDocument(d *indexData, ib *ShardBuilder, repoID int, docID uint32) error
 		return err
 	}
 
+	if doc.Blame, err = d.blameHunks(docID); err != nil {
+		return err
+	}
+
+	if doc.Occurrences, err = d.documentOccurrences(docID); err != nil {
+		return err
+	}
+
 	doc.SymbolsMetaData = make([]*zoekt.Symbol, len(doc.Symbols))
 	for i := range doc.SymbolsMetaData {
 		doc.SymbolsMetaData[i] = d.symbols.data(d.fileEndSymbol[docID] + uint32(i))
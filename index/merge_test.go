@@ -1,8 +1,10 @@
 package index
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -126,3 +128,64 @@ func checkSameShards(t *testing.T, shard1, shard2 string) {
 
 	t.Fatalf("-%s\n+%s:\n%s", shard1, shard2, d)
 }
+
+func TestMergeGroups(t *testing.T) {
+	shard := func(name string, compress bool) IndexFile {
+		b, err := NewShardBuilder(&zoekt.Repository{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.compressContent = compress
+		if err := b.Add(Document{Name: "f", Content: bytes.Repeat([]byte("abc\n"), 100)}); err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := b.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		return &memSeeker{buf.Bytes()}
+	}
+
+	// The content size of compressed shards is that of the uncompressed
+	// content.
+	for _, compress := range []bool{false, true} {
+		sizes, err := mergeSizes(shard("repo", compress))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := sizes["content"], uint64(400); got != want {
+			t.Fatalf("compress=%t: got content size %d, want %d", compress, got, want)
+		}
+	}
+
+	files := []IndexFile{shard("repo0", false), shard("repo1", false), shard("repo2", false)}
+	sizes, err := mergeSizes(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The limit fits the largest section of 2 of the shards, but not of 3.
+	var limit uint64
+	for _, sz := range sizes {
+		limit = max(limit, 2*sz)
+	}
+	for _, tt := range []struct {
+		limit uint64
+		want  []int
+	}{
+		{limit: 3 * limit, want: []int{3}},
+		{limit: limit, want: []int{2, 1}},
+		{limit: 1, want: []int{1, 1, 1}},
+	} {
+		groups, err := mergeGroups(files, tt.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int
+		for _, g := range groups {
+			got = append(got, len(g))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("limit %d: got groups of %v, want %v", tt.limit, got, tt.want)
+		}
+	}
+}
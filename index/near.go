@@ -0,0 +1,126 @@
+package index
+
+import (
+	"fmt"
+	"sort"
+)
+
+// nearMatchTree matches documents that have a content match of every child
+// within distance lines of each other. Its matches span the matches of the
+// children that are near each other, so that they are highlighted as one
+// chunk.
+type nearMatchTree struct {
+	// nextDoc, prepare and the evaluation of the children.
+	andMatchTree
+
+	distance int
+
+	// mutable
+	evaluated bool
+	found     []*candidateMatch
+}
+
+func (t *nearMatchTree) prepare(doc uint32) {
+	t.evaluated = false
+	t.found = t.found[:0]
+	t.andMatchTree.prepare(doc)
+}
+
+func (t *nearMatchTree) String() string {
+	return fmt.Sprintf("near/%d%v", t.distance, t.children)
+}
+
+// nearHit is a content match of the child of a nearMatchTree.
+type nearHit struct {
+	child      int
+	line       int
+	start, end uint32
+}
+
+func (t *nearMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) matchesState {
+	if t.evaluated {
+		return matchesStateForSlice(t.found)
+	}
+
+	if state := t.andMatchTree.matches(cp, cost, known); state != matchesFound {
+		return state
+	}
+
+	nl := cp.newlines()
+	var hits []nearHit
+	for i, ch := range t.children {
+		if !known[ch] {
+			// Children that matched without evaluating content, like
+			// constants, can't be near anything.
+			continue
+		}
+		visitMatches(ch, known, 1, func(mt matchTree, _ float64) {
+			cms, _ := atomMatches(mt)
+			for _, cm := range cms {
+				if cm.fileName {
+					continue
+				}
+				hits = append(hits, nearHit{
+					child: i,
+					line:  nl.atOffset(cm.byteOffset),
+					start: cm.byteOffset,
+					end:   cm.byteOffset + cm.byteMatchSz,
+				})
+			}
+		})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].start < hits[j].start
+	})
+
+	t.found = t.found[:0]
+	for _, span := range nearSpans(hits, len(t.children), t.distance) {
+		t.found = append(t.found, &candidateMatch{
+			byteOffset:  span[0],
+			byteMatchSz: span[1] - span[0],
+		})
+	}
+	t.evaluated = true
+
+	return matchesStateForSlice(t.found)
+}
+
+// nearSpans returns the merged byte ranges of the windows of at most distance
+// lines that contain a hit of each of the numChildren children. hits must be
+// sorted by offset.
+func nearSpans(hits []nearHit, numChildren, distance int) [][2]uint32 {
+	var (
+		spans   [][2]uint32
+		counts  = make([]int, numChildren)
+		covered = 0
+		lo      = 0
+	)
+	for hi, h := range hits {
+		if counts[h.child] == 0 {
+			covered++
+		}
+		counts[h.child]++
+
+		for h.line-hits[lo].line > distance {
+			counts[hits[lo].child]--
+			if counts[hits[lo].child] == 0 {
+				covered--
+			}
+			lo++
+		}
+		if covered < numChildren {
+			continue
+		}
+
+		start, end := hits[lo].start, uint32(0)
+		for _, w := range hits[lo : hi+1] {
+			end = max(end, w.end)
+		}
+		if n := len(spans); n > 0 && spans[n-1][1] >= start {
+			spans[n-1][1] = max(spans[n-1][1], end)
+			continue
+		}
+		spans = append(spans, [2]uint32{start, end})
+	}
+	return spans
+}
@@ -0,0 +1,76 @@
+package index
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestNearSpans(t *testing.T) {
+	hits := []nearHit{
+		{child: 0, line: 1, start: 0, end: 4},
+		{child: 1, line: 3, start: 20, end: 25},
+		{child: 0, line: 10, start: 80, end: 84},
+		{child: 0, line: 20, start: 160, end: 164},
+		{child: 1, line: 21, start: 170, end: 175},
+		{child: 0, line: 22, start: 180, end: 184},
+	}
+	for _, tc := range []struct {
+		distance int
+		want     [][2]uint32
+	}{
+		{0, nil},
+		{1, [][2]uint32{{160, 184}}},
+		{2, [][2]uint32{{0, 25}, {160, 184}}},
+		{7, [][2]uint32{{0, 84}, {160, 184}}},
+	} {
+		if d := cmp.Diff(tc.want, nearSpans(hits, 2, tc.distance)); d != "" {
+			t.Errorf("distance %d: mismatch (-want +got):\n%s", tc.distance, d)
+		}
+	}
+}
+
+func TestNearSearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "near.go", Content: []byte("mu.Lock()\nx++\ndefer mu.Unlock()\n")},
+		Document{Name: "far.go", Content: []byte("mu.Lock()\n\n\n\n\n\n\ndefer mu.Unlock()\n")},
+		Document{Name: "lock.go", Content: []byte("mu.Lock()\n")},
+	)
+
+	q, err := query.Parse("Lock() NEAR/2 defer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := searchForTest(t, b, q, zoekt.SearchOptions{ChunkMatches: true})
+	if len(res.Files) != 1 || res.Files[0].FileName != "near.go" {
+		t.Fatalf("got %v, want near.go", res.Files)
+	}
+
+	// The matches are highlighted as one range in one chunk.
+	var got []string
+	for _, cm := range res.Files[0].ChunkMatches {
+		for _, r := range cm.Ranges {
+			got = append(got, string(cm.Content[r.Start.ByteOffset-cm.ContentStart.ByteOffset:r.End.ByteOffset-cm.ContentStart.ByteOffset]))
+		}
+	}
+	if d := cmp.Diff([]string{"Lock()\nx++\ndefer"}, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	res = searchForTest(t, b, &query.Near{
+		Children: []query.Q{&query.Substring{Pattern: "Lock()"}, &query.Substring{Pattern: "defer"}},
+		Distance: 10,
+	})
+	var names []string
+	for _, f := range res.Files {
+		names = append(names, f.FileName)
+	}
+	sort.Strings(names)
+	if d := cmp.Diff([]string{"far.go", "near.go"}, names); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,353 @@
+package index
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+// Occurrences are stored in two sections. The scipSymbols section holds the
+// distinct symbols referenced by the shard, each encoded as the symbol, name
+// and documentation as uvarint length and bytes. The occurrences section
+// holds one item per document: the occurrences sorted by start offset, each
+// encoded as the delta of the start to the previous start, the length, and
+// the index of the symbol shifted left by one with the definition flag in
+// the lowest bit, all as uvarints. Documents without occurrences have an
+// empty item, and shards without occurrences leave both sections empty.
+
+// scipSymbol is a symbol referenced by occurrences.
+type scipSymbol struct {
+	symbol        string
+	name          string
+	documentation string
+}
+
+// occurrence is the decoded form of an Occurrence.
+type occurrence struct {
+	start, end uint32
+	symbol     uint32
+	definition bool
+}
+
+func appendSCIPSymbol(buf []byte, s *scipSymbol) []byte {
+	for _, str := range []string{s.symbol, s.name, s.documentation} {
+		buf = binary.AppendUvarint(buf, uint64(len(str)))
+		buf = append(buf, str...)
+	}
+	return buf
+}
+
+func decodeSCIPSymbols(blob []byte) ([]scipSymbol, error) {
+	str := func() (string, error) {
+		n, sz := binary.Uvarint(blob)
+		if sz <= 0 || n > uint64(len(blob)-sz) {
+			return "", fmt.Errorf("scipSymbols section: malformed string")
+		}
+		s := string(blob[sz : sz+int(n)])
+		blob = blob[sz+int(n):]
+		return s, nil
+	}
+
+	var symbols []scipSymbol
+	for len(blob) > 0 {
+		var fields [3]string
+		for i := range fields {
+			s, err := str()
+			if err != nil {
+				return nil, err
+			}
+			fields[i] = s
+		}
+		symbols = append(symbols, scipSymbol{symbol: fields[0], name: fields[1], documentation: fields[2]})
+	}
+	return symbols, nil
+}
+
+func decodeOccurrences(blob []byte, numSymbols int) ([]occurrence, error) {
+	uvarint := func() (uint64, error) {
+		v, sz := binary.Uvarint(blob)
+		if sz <= 0 {
+			return 0, fmt.Errorf("occurrences section: malformed uvarint")
+		}
+		blob = blob[sz:]
+		return v, nil
+	}
+
+	var (
+		occs  []occurrence
+		start uint64
+	)
+	for len(blob) > 0 {
+		delta, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		length, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		sym, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if sym>>1 >= uint64(numSymbols) {
+			return nil, fmt.Errorf("occurrences section: symbol %d out of range", sym>>1)
+		}
+
+		start += delta
+		occs = append(occs, occurrence{
+			start:      uint32(start),
+			end:        uint32(start + length),
+			symbol:     uint32(sym >> 1),
+			definition: sym&1 == 1,
+		})
+	}
+	return occs, nil
+}
+
+// addOccurrences records the occurrences of the next document.
+func (b *ShardBuilder) addOccurrences(occs []Occurrence) {
+	occs = append([]Occurrence(nil), occs...)
+	sort.SliceStable(occs, func(i, j int) bool { return occs[i].Start < occs[j].Start })
+
+	var (
+		buf  []byte
+		last uint32
+	)
+	for _, o := range occs {
+		idx, ok := b.scipSymbolIndex[o.Symbol]
+		if !ok {
+			idx = uint32(len(b.scipSymbols))
+			b.scipSymbolIndex[o.Symbol] = idx
+			b.scipSymbols = append(b.scipSymbols, scipSymbol{symbol: o.Symbol})
+		}
+		// Not every occurrence carries the name and documentation, so take
+		// them from the first one that does.
+		if s := &b.scipSymbols[idx]; s.name == "" || s.documentation == "" {
+			if s.name == "" {
+				s.name = o.Name
+			}
+			if s.documentation == "" {
+				s.documentation = o.Documentation
+			}
+		}
+
+		sym := uint64(idx) << 1
+		if o.Definition {
+			sym |= 1
+		}
+		buf = binary.AppendUvarint(buf, uint64(o.Start-last))
+		buf = binary.AppendUvarint(buf, uint64(o.End-o.Start))
+		buf = binary.AppendUvarint(buf, sym)
+		last = o.Start
+	}
+	b.occurrences = append(b.occurrences, buf)
+}
+
+// hasOccurrences returns true if the shard holds occurrences.
+func (d *indexData) hasOccurrences() bool {
+	return len(d.occurrencesIndex) > 0
+}
+
+// readOccurrences returns the occurrences of a document sorted by start
+// offset, or nil if it has none.
+func (d *indexData) readOccurrences(docID uint32) ([]occurrence, error) {
+	if !d.hasOccurrences() {
+		return nil, nil
+	}
+
+	blob, err := d.readSectionBlob(simpleSection{
+		off: d.occurrencesStart + uint64(d.occurrencesIndex[docID]),
+		sz:  d.occurrencesIndex[docID+1] - d.occurrencesIndex[docID],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeOccurrences(blob, len(d.scipSymbols))
+}
+
+// documentOccurrences returns the occurrences of a document in the form of
+// Document.Occurrences.
+func (d *indexData) documentOccurrences(docID uint32) ([]Occurrence, error) {
+	occs, err := d.readOccurrences(docID)
+	if err != nil || occs == nil {
+		return nil, err
+	}
+
+	out := make([]Occurrence, 0, len(occs))
+	for _, o := range occs {
+		s := &d.scipSymbols[o.symbol]
+		out = append(out, Occurrence{
+			Start:         o.start,
+			End:           o.end,
+			Symbol:        s.symbol,
+			Name:          s.name,
+			Documentation: s.documentation,
+			Definition:    o.definition,
+		})
+	}
+	return out, nil
+}
+
+// newOccurrenceMatchTree returns a matchTree for the definitions or
+// references of the symbols selected by q.
+func (d *indexData) newOccurrenceMatchTree(q *query.Occurrence) matchTree {
+	if !d.hasOccurrences() {
+		return &noMatchTree{Why: "no occurrences"}
+	}
+
+	selected := make([]bool, len(d.scipSymbols))
+	found := false
+	for i, s := range d.scipSymbols {
+		if q.Symbol != "" {
+			selected[i] = s.symbol == q.Symbol
+		} else {
+			selected[i] = q.Regexp.MatchString(s.name)
+		}
+		found = found || selected[i]
+	}
+	if !found {
+		return &noMatchTree{Why: "no matching symbols"}
+	}
+
+	return &occurrenceMatchTree{
+		docMatchTree: docMatchTree{
+			reason:  "occurrences",
+			numDocs: d.numDocs(),
+			predicate: func(docID uint32) bool {
+				return d.occurrencesIndex[docID+1] > d.occurrencesIndex[docID]
+			},
+		},
+		d:          d,
+		selected:   selected,
+		definition: q.Definition,
+	}
+}
+
+// occurrenceMatchTree matches the occurrences of the selected symbols in a
+// document.
+type occurrenceMatchTree struct {
+	// nextDoc, prepare.
+	docMatchTree
+
+	d *indexData
+
+	// selected is indexed by the symbol numbers of the shard.
+	selected   []bool
+	definition bool
+
+	// mutable
+	evaluated bool
+	found     []*candidateMatch
+}
+
+func (t *occurrenceMatchTree) prepare(doc uint32) {
+	t.evaluated = false
+	t.found = t.found[:0]
+	t.docMatchTree.prepare(doc)
+}
+
+func (t *occurrenceMatchTree) String() string {
+	kind := "ref"
+	if t.definition {
+		kind = "def"
+	}
+	return fmt.Sprintf("occurrence(%s)", kind)
+}
+
+func (t *occurrenceMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) matchesState {
+	if t.evaluated {
+		return matchesStateForSlice(t.found)
+	}
+
+	if cost < costContent {
+		return matchesRequiresHigherCost
+	}
+
+	occs, err := t.d.readOccurrences(cp.idx)
+	if err != nil {
+		return matchesNone
+	}
+
+	found := t.found[:0]
+	for _, o := range occs {
+		if o.definition != t.definition || !t.selected[o.symbol] {
+			continue
+		}
+		found = append(found, &candidateMatch{
+			byteOffset:  o.start,
+			byteMatchSz: o.end - o.start,
+		})
+	}
+	t.found = found
+	t.evaluated = true
+
+	return matchesStateForSlice(t.found)
+}
+
+// annotateOccurrences adds the precise symbol of the occurrence each match
+// of fm covers to its SymbolInfo. fm holds the matches of docID.
+func (d *indexData) annotateOccurrences(fm *zoekt.FileMatch, docID uint32) error {
+	occs, err := d.readOccurrences(docID)
+	if err != nil || occs == nil {
+		return err
+	}
+
+	// symbolAt returns the symbol info for the match at [start, end), with
+	// the precise symbol of a covering occurrence added to si.
+	symbolAt := func(si *zoekt.Symbol, start, end uint32) *zoekt.Symbol {
+		i := sort.Search(len(occs), func(i int) bool { return occs[i].start > start })
+		for i--; i >= 0; i-- {
+			o := occs[i]
+			if o.end < end {
+				continue
+			}
+			s := &d.scipSymbols[o.symbol]
+			annotated := &zoekt.Symbol{Sym: s.name}
+			if si != nil {
+				c := *si
+				annotated = &c
+			}
+			annotated.SCIPSymbol = s.symbol
+			annotated.Documentation = s.documentation
+			return annotated
+		}
+		return si
+	}
+
+	for i := range fm.LineMatches {
+		lm := &fm.LineMatches[i]
+		if lm.FileName {
+			continue
+		}
+		for j := range lm.LineFragments {
+			f := &lm.LineFragments[j]
+			f.SymbolInfo = symbolAt(f.SymbolInfo, f.Offset, f.Offset+uint32(f.MatchLength))
+		}
+	}
+
+	for i := range fm.ChunkMatches {
+		cm := &fm.ChunkMatches[i]
+		if cm.FileName {
+			continue
+		}
+		for j, r := range cm.Ranges {
+			var si *zoekt.Symbol
+			if cm.SymbolInfo != nil {
+				si = cm.SymbolInfo[j]
+			}
+			annotated := symbolAt(si, r.Start.ByteOffset, r.End.ByteOffset)
+			if annotated == si {
+				continue
+			}
+			if cm.SymbolInfo == nil {
+				cm.SymbolInfo = make([]*zoekt.Symbol, len(cm.Ranges))
+			}
+			cm.SymbolInfo[j] = annotated
+		}
+	}
+	return nil
+}
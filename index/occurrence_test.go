@@ -0,0 +1,152 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestOccurrences(t *testing.T) {
+	const (
+		newFoo = "scip-go gomod example v1 `example/pkg`/NewFoo()."
+		foo    = "scip-go gomod example v1 `example/pkg`/Foo#"
+	)
+
+	// occ returns the occurrence of sym at the first instance of text
+	// in content.
+	occ := func(content, text, sym, name string, def bool) Occurrence {
+		i := regexp.MustCompile(regexp.QuoteMeta(text)).FindStringIndex(content)
+		return Occurrence{
+			Start:         uint32(i[0]),
+			End:           uint32(i[1]),
+			Symbol:        sym,
+			Name:          name,
+			Documentation: "docs of " + name,
+			Definition:    def,
+		}
+	}
+
+	defContent := "type Foo struct{}\n\nfunc NewFoo() *Foo { return nil }\n"
+	refContent := "var f = pkg.NewFoo()\n"
+	docs := []Document{
+		{
+			Name:    "foo.go",
+			Content: []byte(defContent),
+			Occurrences: []Occurrence{
+				// Deliberately out of order.
+				occ(defContent, "NewFoo", newFoo, "NewFoo", true),
+				occ(defContent, "Foo", foo, "Foo", true),
+				occ(defContent, "*Foo", foo, "Foo", false),
+			},
+		},
+		{
+			Name:        "main.go",
+			Content:     []byte(refContent),
+			Occurrences: []Occurrence{occ(refContent, "NewFoo", newFoo, "NewFoo", false)},
+		},
+		{Name: "other.go", Content: []byte("NewFoo is not an occurrence here\n")},
+	}
+	// The occurrence of Foo in "*Foo" excludes the star.
+	docs[0].Occurrences[2].Start++
+
+	b := testShardBuilder(t, nil, docs...)
+
+	matches := func(res *zoekt.SearchResult) []string {
+		var got []string
+		for _, f := range res.Files {
+			for _, lm := range f.LineMatches {
+				for _, fr := range lm.LineFragments {
+					got = append(got, f.FileName+":"+string(lm.Line[fr.LineOffset:fr.LineOffset+fr.MatchLength]))
+				}
+			}
+		}
+		return got
+	}
+
+	for _, tc := range []struct {
+		q    query.Q
+		want []string
+	}{
+		{&query.Occurrence{Regexp: regexp.MustCompile("^NewFoo$"), Definition: true}, []string{"foo.go:NewFoo"}},
+		{&query.Occurrence{Regexp: regexp.MustCompile("^NewFoo$")}, []string{"main.go:NewFoo"}},
+		{&query.Occurrence{Regexp: regexp.MustCompile("Foo"), Definition: true}, []string{"foo.go:Foo", "foo.go:NewFoo"}},
+		{&query.Occurrence{Symbol: foo}, []string{"foo.go:Foo"}},
+		{&query.Occurrence{Regexp: regexp.MustCompile("Bar")}, nil},
+		{query.NewAnd(&query.Occurrence{Regexp: regexp.MustCompile("NewFoo")}, &query.Substring{Pattern: "var"}), []string{"main.go:var", "main.go:NewFoo"}},
+	} {
+		if d := cmp.Diff(tc.want, matches(searchForTest(t, b, tc.q))); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.q, d)
+		}
+	}
+
+	t.Run("References", func(t *testing.T) {
+		res, err := searcherForTest(t, b).References(context.Background(), newFoo, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := cmp.Diff([]string{"main.go:NewFoo"}, matches(res)); d != "" {
+			t.Errorf("mismatch (-want +got):\n%s", d)
+		}
+	})
+
+	t.Run("SymbolInfo", func(t *testing.T) {
+		want := &zoekt.Symbol{Sym: "NewFoo", SCIPSymbol: newFoo, Documentation: "docs of NewFoo"}
+
+		res := searchForTest(t, b, &query.Substring{Pattern: "NewFoo", Content: true})
+		got := map[string]*zoekt.Symbol{}
+		for _, f := range res.Files {
+			got[f.FileName] = f.LineMatches[0].LineFragments[0].SymbolInfo
+		}
+		if d := cmp.Diff(want, got["main.go"]); d != "" {
+			t.Errorf("main.go: mismatch (-want +got):\n%s", d)
+		}
+		if got["other.go"] != nil {
+			t.Errorf("other.go: got %+v, want no symbol info", got["other.go"])
+		}
+
+		res = searchForTest(t, b, &query.Substring{Pattern: "NewFoo", Content: true}, zoekt.SearchOptions{ChunkMatches: true})
+		for _, f := range res.Files {
+			if f.FileName != "main.go" {
+				continue
+			}
+			if d := cmp.Diff([]*zoekt.Symbol{want}, f.ChunkMatches[0].SymbolInfo); d != "" {
+				t.Errorf("chunk: mismatch (-want +got):\n%s", d)
+			}
+		}
+	})
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		d := searcherForTest(t, b).(*indexData)
+		got, err := d.documentOccurrences(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Occurrence{docs[0].Occurrences[1], docs[0].Occurrences[0], docs[0].Occurrences[2]}
+		if d := cmp.Diff(want, got); d != "" {
+			t.Errorf("mismatch (-want +got):\n%s", d)
+		}
+		if got, err := d.documentOccurrences(2); err != nil || got != nil {
+			t.Errorf("got %v, %v for document without occurrences", got, err)
+		}
+	})
+}
+
+func TestOccurrencesPastEndOfContent(t *testing.T) {
+	b, err := NewShardBuilder(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.Add(Document{
+		Name:        "f",
+		Content:     []byte("short"),
+		Occurrences: []Occurrence{{Start: 2, End: 10, Symbol: "s"}},
+	})
+	if err == nil {
+		t.Fatal("got no error for occurrence past end of content")
+	}
+}
@@ -17,6 +17,7 @@ package index
 import (
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc64"
 	"log"
@@ -34,9 +35,12 @@ import (
 
 // IndexFile is a file suitable for concurrent read access. For performance
 // reasons, it allows a mmap'd implementation.
+//
+// Files may be larger than 4 GiB, but a single read never is: it reads at
+// most one section, and sections hold at most 4 GiB.
 type IndexFile interface {
-	Read(off uint32, sz uint32) ([]byte, error)
-	Size() (uint32, error)
+	Read(off uint64, sz uint32) ([]byte, error)
+	Size() (uint64, error)
 	Close()
 	Name() string
 }
@@ -44,10 +48,14 @@ type IndexFile interface {
 // reader is a stateful file
 type reader struct {
 	r   IndexFile
-	off uint32
+	off uint64
+
+	// verify is set if readIndexData verifies the checksums of the
+	// sections.
+	verify bool
 }
 
-func (r *reader) seek(off uint32) {
+func (r *reader) seek(off uint64) {
 	r.off = off
 }
 
@@ -95,7 +103,7 @@ func (r *reader) Str() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	r.off += uint32(slen)
+	r.off += slen
 	return string(b), nil
 }
 
@@ -117,7 +125,7 @@ func (r *reader) readTOCSections(toc *indexTOC, tags []string) error {
 		// tagged sections are indicated by a 0 sectionCount,
 		// and then a list of string-tagged type-indicated sections.
 		secs := toc.sectionsTagged()
-		for r.off < tocSection.off+tocSection.sz {
+		for r.off < tocSection.off+uint64(tocSection.sz) {
 			tag, err := r.Str()
 			if err != nil {
 				return err
@@ -126,6 +134,10 @@ func (r *reader) readTOCSections(toc *indexTOC, tags []string) error {
 			if err != nil {
 				return err
 			}
+			wide := sectionKind(kind) >= sectionKindWide
+			if wide {
+				kind -= uint64(sectionKindWide)
+			}
 
 			skipSection := len(tags) > 0 && !slices.Contains(tags, tag)
 			sec := secs[tag]
@@ -148,11 +160,11 @@ func (r *reader) readTOCSections(toc *indexTOC, tags []string) error {
 			}
 
 			if skipSection {
-				if err := sec.skip(r); err != nil {
+				if err := sec.skip(r, wide); err != nil {
 					return err
 				}
 			} else {
-				if err := sec.read(r); err != nil {
+				if err := sec.read(r, wide); err != nil {
 					return err
 				}
 			}
@@ -171,7 +183,7 @@ func (r *reader) readTOCSections(toc *indexTOC, tags []string) error {
 		}
 
 		for _, s := range secs {
-			if err := s.read(r); err != nil {
+			if err := s.read(r, false); err != nil {
 				return err
 			}
 		}
@@ -184,10 +196,26 @@ func (r *reader) readHeader() (simpleSection, uint32, error) {
 	if err != nil {
 		return simpleSection{}, 0, err
 	}
-	r.off = sz - 8
+	if sz < 8 {
+		return simpleSection{}, 0, fmt.Errorf("file has %d bytes, too small for a trailer", sz)
+	}
+	r.off = sz - 4
+	magic, err := r.U32()
+	if err != nil {
+		return simpleSection{}, 0, err
+	}
+	wide := magic == wideTrailerMagic
+	if wide {
+		if sz < 16 {
+			return simpleSection{}, 0, fmt.Errorf("file has %d bytes, too small for a trailer", sz)
+		}
+		r.off = sz - 16
+	} else {
+		r.off = sz - 8
+	}
 
 	var tocSection simpleSection
-	if err := tocSection.read(r); err != nil {
+	if err := tocSection.read(r, wide); err != nil {
 		return simpleSection{}, 0, err
 	}
 
@@ -245,6 +273,10 @@ func (r *reader) readJSON(data any, sec simpleSection) error {
 	return json.Unmarshal(blob, data)
 }
 
+// errUnsupportedVersion is returned for shards written in a version this
+// reader can't read.
+var errUnsupportedVersion = errors.New("unsupported version")
+
 // canReadVersion returns checks if zoekt can read in md. If it can't a
 // non-nil error is returned.
 func canReadVersion(md *zoekt.IndexMetadata) bool {
@@ -263,9 +295,15 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 		docMatchTreeCache: newDocMatchTreeCache(0),
 	}
 
+	if r.verify {
+		if err := r.verifyChecksums(toc); err != nil {
+			return nil, err
+		}
+	}
+
 	repos, md, err := r.parseMetadata(toc.metaData, toc.repoMetaData)
 	if md != nil && !canReadVersion(md) {
-		return nil, fmt.Errorf("file is v%d, want v%d", md.IndexFormatVersion, IndexFormatVersion)
+		return nil, fmt.Errorf("%w: file is v%d, want v%d", errUnsupportedVersion, md.IndexFormatVersion, IndexFormatVersion)
 	} else if err != nil {
 		return nil, err
 	}
@@ -277,15 +315,24 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 	}
 
 	if d.metaData.IndexFeatureVersion < ReadMinFeatureVersion {
-		return nil, fmt.Errorf("file is feature version %d, want feature version >= %d", d.metaData.IndexFeatureVersion, ReadMinFeatureVersion)
+		return nil, fmt.Errorf("%w: file is feature version %d, want feature version >= %d", errUnsupportedVersion, d.metaData.IndexFeatureVersion, ReadMinFeatureVersion)
 	}
 
 	if d.metaData.IndexMinReaderVersion > FeatureVersion {
-		return nil, fmt.Errorf("file needs read feature version >= %d, have read feature version %d", d.metaData.IndexMinReaderVersion, FeatureVersion)
+		return nil, fmt.Errorf("%w: file needs read feature version >= %d, have read feature version %d", errUnsupportedVersion, d.metaData.IndexMinReaderVersion, FeatureVersion)
 	}
 
 	d.boundariesStart = toc.fileContents.data.off
 	d.boundaries = toc.fileContents.relativeIndex()
+	if toc.contentBoundaries.sz > 0 {
+		if d.boundaries, err = readSectionU32(d.file, toc.contentBoundaries); err != nil {
+			return nil, err
+		}
+		d.contentBlocks, err = newContentBlocks(d.file, toc.contentBlocks, d.boundaries[len(d.boundaries)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
 	d.newlinesStart = toc.newlines.data.off
 	d.newlinesIndex = toc.newlines.relativeIndex()
 	d.docSectionsStart = toc.fileSections.data.off
@@ -325,6 +372,39 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 		return nil, err
 	}
 
+	blob, err := d.readSectionBlob(toc.commits)
+	if err != nil {
+		return nil, err
+	}
+	if d.commits, err = decodeCommits(blob, len(d.boundaries)-1); err != nil {
+		return nil, err
+	}
+
+	blob, err = d.readSectionBlob(toc.blameCommits)
+	if err != nil {
+		return nil, err
+	}
+	if d.blameCommits, err = decodeBlameCommits(blob); err != nil {
+		return nil, err
+	}
+	d.blameStart = toc.blame.data.off
+	d.blameIndex = toc.blame.relativeIndex()
+
+	blob, err = d.readSectionBlob(toc.scipSymbols)
+	if err != nil {
+		return nil, err
+	}
+	if d.scipSymbols, err = decodeSCIPSymbols(blob); err != nil {
+		return nil, err
+	}
+	d.occurrencesStart = toc.occurrences.data.off
+	d.occurrencesIndex = toc.occurrences.relativeIndex()
+
+	d.fingerprints, err = d.readSectionBlob(toc.fingerprints)
+	if err != nil {
+		return nil, err
+	}
+
 	d.contentNgrams, err = d.newBtreeIndex(toc.ngramText, toc.postings)
 	if err != nil {
 		return nil, err
@@ -360,7 +440,7 @@ func (r *reader) readIndexData(toc *indexTOC) (*indexData, error) {
 		d.rawConfigMasks = append(d.rawConfigMasks, encodeRawConfig(md.RawConfig))
 	}
 
-	blob, err := d.readSectionBlob(toc.runeDocSections)
+	blob, err = d.readSectionBlob(toc.runeDocSections)
 	if err != nil {
 		return nil, err
 	}
@@ -435,7 +515,7 @@ func (r *reader) parseMetadata(metaData simpleSection, repoMetaData simpleSectio
 	// Sourcegraph specific: we support mutating metadata via an additional
 	// ".meta" file. This is to support tombstoning. An additional benefit is we
 	// can update metadata (such as Rank and Name) without re-indexing content.
-	blob, err := os.ReadFile(r.r.Name() + ".meta")
+	blob, err := readIndexFileSibling(r.r.Name() + ".meta")
 	if err != nil && !os.IsNotExist(err) {
 		return nil, &md, fmt.Errorf("failed to read meta file: %w", err)
 	}
@@ -494,6 +574,7 @@ func (d *indexData) newBtreeIndex(ngramSec simpleSection, postings compoundSecti
 	// hold on to simple sections (8 bytes each)
 	bi.ngramSec = ngramSec
 	bi.postingIndex = postings.index
+	bi.postingBase = postings.base()
 
 	return bi, nil
 }
@@ -518,28 +599,38 @@ func (d *indexData) verify() error {
 			return fmt.Errorf("got %s %d, want %d", what, got, n)
 		}
 	}
+	if got := len(d.fingerprints); got > 0 && got != n*signatureBytes {
+		return fmt.Errorf("got fingerprints %d bytes, want %d", got, n*signatureBytes)
+	}
 	return nil
 }
 
 func (d *indexData) readContents(i uint32) ([]byte, error) {
+	if d.contentBlocks != nil {
+		return d.contentBlocks.read(d.boundaries[i], d.boundaries[i+1]-d.boundaries[i])
+	}
 	return d.readSectionBlob(simpleSection{
-		off: d.boundariesStart + d.boundaries[i],
+		off: d.boundariesStart + uint64(d.boundaries[i]),
 		sz:  d.boundaries[i+1] - d.boundaries[i],
 	})
 }
 
 func (d *indexData) readContentSlice(off uint32, sz uint32) ([]byte, error) {
-	// TODO(hanwen): cap result if it is at the end of the content
-	// section.
+	if end := d.boundaries[len(d.boundaries)-1]; off+sz > end {
+		sz = end - min(off, end)
+	}
+	if d.contentBlocks != nil {
+		return d.contentBlocks.read(off, sz)
+	}
 	return d.readSectionBlob(simpleSection{
-		off: d.boundariesStart + off,
+		off: d.boundariesStart + uint64(off),
 		sz:  sz,
 	})
 }
 
 func (d *indexData) readNewlines(i uint32, buf []uint32) ([]uint32, uint32, error) {
 	sec := simpleSection{
-		off: d.newlinesStart + d.newlinesIndex[i],
+		off: d.newlinesStart + uint64(d.newlinesIndex[i]),
 		sz:  d.newlinesIndex[i+1] - d.newlinesIndex[i],
 	}
 	blob, err := d.readSectionBlob(sec)
@@ -559,7 +650,7 @@ func (d *indexData) readNewlines(i uint32, buf []uint32) ([]uint32, uint32, erro
 
 func (d *indexData) readDocSections(i uint32, buf []DocumentSection) ([]DocumentSection, uint32, error) {
 	sec := simpleSection{
-		off: d.docSectionsStart + d.docSectionsIndex[i],
+		off: d.docSectionsStart + uint64(d.docSectionsIndex[i]),
 		sz:  d.docSectionsIndex[i+1] - d.docSectionsIndex[i],
 	}
 	blob, err := d.readSectionBlob(sec)
@@ -583,7 +674,7 @@ func (d *indexData) readDocSections(i uint32, buf []DocumentSection) ([]Document
 // of the Searcher itself, ie. []byte members should be copied into
 // fresh buffers if the result is to survive closing the shard.
 func NewSearcher(r IndexFile) (zoekt.Searcher, error) {
-	rd := &reader{r: r}
+	rd := &reader{r: r, verify: verifyChecksumsOnLoad}
 
 	var toc indexTOC
 	if err := rd.readTOC(&toc); err != nil {
@@ -74,8 +74,8 @@ func TestReadWrite(t *testing.T) {
 		t.Fatalf("got ngrams %v, want 3 ngrams", contentNgrams)
 	}
 
-	if sec := data.contentNgrams.Get(stringToNGram("bcq")); sec.sz > 0 {
-		t.Errorf("found ngram bcq (%v) in %v", uint64(stringToNGram("bcq")), contentNgrams)
+	if sec, err := data.contentNgrams.Get(stringToNGram("bcq")); err != nil || sec.sz > 0 {
+		t.Errorf("found ngram bcq (%v) in %v: %v", uint64(stringToNGram("bcq")), contentNgrams, err)
 	}
 }
 
@@ -113,12 +113,12 @@ func TestReadWriteNames(t *testing.T) {
 		t.Errorf("got index %v, want {0,4}", data.fileNameIndex)
 	}
 
-	gotSec := data.fileNameNgrams.Get(stringToNGram("bCd"))
+	gotSec, err := data.fileNameNgrams.Get(stringToNGram("bCd"))
 	if err != nil {
-		t.Fatalf("fileNameNgrams.GetBlob: %v", err)
+		t.Fatalf("fileNameNgrams.Get: %v", err)
 	}
 
-	if !reflect.DeepEqual(buf.Bytes()[gotSec.off:gotSec.off+gotSec.sz], []byte{1}) {
+	if !reflect.DeepEqual(buf.Bytes()[gotSec.off:gotSec.off+uint64(gotSec.sz)], []byte{1}) {
 		t.Errorf("got trigram bcd at bits %v, want sz 2", data.fileNameNgrams)
 	}
 }
@@ -151,7 +151,7 @@ func TestGet(t *testing.T) {
 		t.Fatalf("readIndexData: %v", err)
 	}
 
-	var off uint32 = 96
+	var off uint64 = 96
 
 	cases := []struct {
 		ng              string
@@ -189,7 +189,10 @@ func TestGet(t *testing.T) {
 
 	for _, tt := range cases {
 		t.Run(tt.ng, func(t *testing.T) {
-			havePostingList := id.contentNgrams.Get(stringToNGram(tt.ng))
+			havePostingList, err := id.contentNgrams.Get(stringToNGram(tt.ng))
+			if err != nil {
+				t.Fatal(err)
+			}
 			if !reflect.DeepEqual(tt.wantPostingList, havePostingList) {
 				t.Fatalf("\nwant:%+v\ngot: %+v", tt.wantPostingList, havePostingList)
 			}
@@ -423,14 +426,88 @@ func TestBackwardsCompat(t *testing.T) {
 					t.Fatalf("got ngrams %v, want 3 ngrams", contentNgrams)
 				}
 
-				if sec := data.contentNgrams.Get(stringToNGram("bcq")); sec.sz > 0 {
-					t.Errorf("found ngram bcd in %v", contentNgrams)
+				if sec, err := data.contentNgrams.Get(stringToNGram("bcq")); err != nil || sec.sz > 0 {
+					t.Errorf("found ngram bcd in %v: %v", contentNgrams, err)
 				}
 			},
 		)
 	}
 }
 
+// offsetSeeker is an IndexFile whose data starts at off. The bytes before
+// it are never read, except for empty sections.
+type offsetSeeker struct {
+	off  uint64
+	data []byte
+}
+
+func (s *offsetSeeker) Name() string { return "offsetseeker" }
+func (s *offsetSeeker) Close()       {}
+
+func (s *offsetSeeker) Read(off uint64, sz uint32) ([]byte, error) {
+	if sz == 0 {
+		return []byte{}, nil
+	}
+	if off < s.off || off+uint64(sz) > s.off+uint64(len(s.data)) {
+		return nil, fmt.Errorf("out of bounds: [%d, %d)", off, off+uint64(sz))
+	}
+	return s.data[off-s.off : off-s.off+uint64(sz)], nil
+}
+
+func (s *offsetSeeker) Size() (uint64, error) {
+	return s.off + uint64(len(s.data)), nil
+}
+
+func TestWideOffsets(t *testing.T) {
+	docs := []Document{
+		{Name: "f1", Content: []byte("package main\n\nfunc main() {}\n")},
+		{Name: "f2", Content: []byte("needle in a haystack\n")},
+	}
+	want := searcherForTest(t, testShardBuilder(t, &zoekt.Repository{Name: "repo"}, docs...))
+
+	q := &query.Substring{Pattern: "needle", Content: true}
+	wantRes, err := want.Search(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, off := range []uint64{
+		// Sections straddle the 4 GiB mark.
+		1<<32 - 200,
+		1 << 33,
+	} {
+		b := testShardBuilder(t, &zoekt.Repository{Name: "repo"}, docs...)
+
+		var buf bytes.Buffer
+		w := &writer{w: &buf, off: off}
+		if err := b.write(w); err != nil {
+			t.Fatal(err)
+		}
+
+		f := &offsetSeeker{off: off, data: buf.Bytes()}
+		var toc indexTOC
+		r := reader{r: f}
+		if err := r.readTOC(&toc); err != nil {
+			t.Fatalf("readTOC: %v", err)
+		}
+		if !toc.postings.isWide {
+			t.Errorf("off %d: got narrow postings section", off)
+		}
+
+		s, err := NewSearcher(f)
+		if err != nil {
+			t.Fatalf("NewSearcher: %v", err)
+		}
+		res, err := s.Search(context.Background(), q, &zoekt.SearchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := cmp.Diff(wantRes.Files, res.Files); d != "" {
+			t.Errorf("off %d: mismatch (-want +got):\n%s", off, d)
+		}
+	}
+}
+
 func TestBackfillIDIsDeterministic(t *testing.T) {
 	repo := "github.com/a/b"
 	have1 := backfillID(repo)
@@ -0,0 +1,263 @@
+package index
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"regexp/syntax"
+	"strconv"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+const (
+	// refsBoostDefiningPackage is the score weight of references in the
+	// directory of a definition, ie. in the package defining the symbol.
+	refsBoostDefiningPackage = 3
+
+	// refsBoostImportingPackage is the score weight of references in files
+	// importing the package defining the symbol.
+	refsBoostImportingPackage = 2
+
+	// refsMaxDefinitionFiles bounds the number of files whose definitions
+	// of the identifier rank the references.
+	refsMaxDefinitionFiles = 1000
+)
+
+// symbolRefsMatchTree matches the whole word occurrences of an identifier
+// that don't overlap a symbol section, ie. aren't definitions.
+type symbolRefsMatchTree struct {
+	// word matching, nextDoc, prepare.
+	wordMatchTree
+
+	// dirs holds the directories with a definition of the identifier.
+	dirs map[refsDir]struct{}
+
+	// packages holds the names of the packages defining the identifier.
+	packages []string
+
+	// mutable
+	boost float64
+}
+
+// refsDir is a directory of a repository.
+type refsDir struct {
+	repo string
+	dir  string
+}
+
+func (d *indexData) newSymbolRefsMatchTree(q *query.SymbolRefs) (matchTree, error) {
+	dirs := map[refsDir]struct{}{}
+	var packages []string
+	seen := map[string]bool{}
+	for _, def := range q.Definitions {
+		dirs[refsDir{repo: def.Repository, dir: def.Dir}] = struct{}{}
+		if def.Package != "" && !seen[def.Package] {
+			seen[def.Package] = true
+			packages = append(packages, def.Package)
+		}
+	}
+
+	// The substring tree narrows down the documents to consider, but its
+	// matches are covered by the word matches.
+	subMT, err := d.newSubstringMatchTree(&query.Substring{Pattern: q.Name, CaseSensitive: true, Content: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &andMatchTree{
+		children: []matchTree{
+			&symbolRefsMatchTree{
+				wordMatchTree: wordMatchTree{word: q.Name},
+				dirs:          dirs,
+				packages:      packages,
+				boost:         1,
+			},
+			&noVisitMatchTree{subMT},
+		},
+	}, nil
+}
+
+// ResolveSymbolRefs returns q with the definitions of the identifiers of its
+// SymbolRefs queries, which it finds with search. Searchers over several
+// shards resolve the definitions with a search over all of them, so that a
+// definition ranks references in other shards too.
+func ResolveSymbolRefs(ctx context.Context, q query.Q, search func(context.Context, query.Q, *zoekt.SearchOptions) (*zoekt.SearchResult, error)) (query.Q, error) {
+	unresolved := false
+	query.VisitAtoms(q, func(q query.Q) {
+		if r, ok := q.(*query.SymbolRefs); ok && r.Definitions == nil {
+			unresolved = true
+		}
+	})
+	if !unresolved {
+		return q, nil
+	}
+
+	var err error
+	q = query.Map(q, func(q query.Q) query.Q {
+		r, ok := q.(*query.SymbolRefs)
+		if !ok || r.Definitions != nil || err != nil {
+			return q
+		}
+		var defs []query.SymbolDefinition
+		defs, err = symbolDefinitions(ctx, r.Name, search)
+		return &query.SymbolRefs{Name: r.Name, Definitions: defs}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// symbolDefinitions returns the definitions of the identifier name found by
+// a symbol search with search. The result is not nil.
+func symbolDefinitions(ctx context.Context, name string, search func(context.Context, query.Q, *zoekt.SearchOptions) (*zoekt.SearchResult, error)) ([]query.SymbolDefinition, error) {
+	re, err := syntax.Parse("^"+regexp.QuoteMeta(name)+"$", syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	q := &query.Symbol{Expr: &query.Regexp{Regexp: re, Content: true, CaseSensitive: true}}
+
+	res, err := search(ctx, q, &zoekt.SearchOptions{
+		ChunkMatches:       true,
+		MaxDocDisplayCount: refsMaxDefinitionFiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refs: looking up definitions of %q: %w", name, err)
+	}
+
+	defs := []query.SymbolDefinition{}
+	seen := map[query.SymbolDefinition]bool{}
+	add := func(f *zoekt.FileMatch, sym *zoekt.Symbol) {
+		def := query.SymbolDefinition{
+			Repository: f.Repository,
+			Dir:        path.Dir(f.FileName),
+			Package:    symbolPackage(f.FileName, sym),
+		}
+		if !seen[def] {
+			seen[def] = true
+			defs = append(defs, def)
+		}
+	}
+	for i := range res.Files {
+		f := &res.Files[i]
+		for _, cm := range f.ChunkMatches {
+			if len(cm.SymbolInfo) == 0 {
+				add(f, nil)
+			}
+			for _, sym := range cm.SymbolInfo {
+				add(f, sym)
+			}
+		}
+	}
+	return defs, nil
+}
+
+// symbolPackage returns the name of the package of a symbol defined in
+// fileName. For languages without explicit package parents, like Go, this
+// is the name of the directory.
+func symbolPackage(fileName string, sym *zoekt.Symbol) string {
+	if sym != nil {
+		switch sym.ParentKind {
+		case "package", "namespace", "module":
+			return sym.Parent
+		}
+	}
+	dir := path.Dir(fileName)
+	if dir == "." {
+		return ""
+	}
+	return path.Base(dir)
+}
+
+func (t *symbolRefsMatchTree) prepare(doc uint32) {
+	t.boost = 1
+	t.wordMatchTree.prepare(doc)
+}
+
+func (t *symbolRefsMatchTree) String() string {
+	return fmt.Sprintf("refs(%s)", t.word)
+}
+
+func (t *symbolRefsMatchTree) matches(cp *contentProvider, cost int, known map[matchTree]bool) matchesState {
+	if t.evaluated {
+		return matchesStateForSlice(t.found)
+	}
+
+	if state := t.wordMatchTree.matches(cp, cost, known); state != matchesFound {
+		return state
+	}
+
+	refs := t.found[:0]
+	for _, cm := range t.found {
+		if _, _, ok := cp.findSymbol(cm); !ok {
+			refs = append(refs, cm)
+		}
+	}
+	t.found = refs
+	if len(t.found) == 0 {
+		return matchesNone
+	}
+
+	fileName := string(cp.id.fileName(cp.idx))
+	repo := cp.id.repoMetaData[cp.id.repos[cp.idx]].Name
+	if _, ok := t.dirs[refsDir{repo: repo, dir: path.Dir(fileName)}]; ok {
+		t.boost = refsBoostDefiningPackage
+	} else {
+		content := cp.data(false)
+		for _, pkg := range t.packages {
+			if importsPackage(content, pkg) {
+				t.boost = refsBoostImportingPackage
+				break
+			}
+		}
+	}
+	return matchesFound
+}
+
+// importsPackage returns true if content appears to import the package
+// named pkg: either a line starting with an import keyword mentions pkg as
+// a word, or a quoted import path ends with pkg.
+func importsPackage(content []byte, pkg string) bool {
+	for _, line := range bytes.Split(content, []byte{'\n'}) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		for _, kw := range []string{"import", "from ", "use ", "using ", "#include", "require"} {
+			if bytes.HasPrefix(line, []byte(kw)) && containsWord(line, pkg) {
+				return true
+			}
+		}
+
+		// Go import blocks list one optionally named path per line.
+		if fields := bytes.Fields(line); len(fields) <= 2 {
+			p, err := strconv.Unquote(string(fields[len(fields)-1]))
+			if err == nil && (p == pkg || path.Base(p) == pkg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsWord returns true if word occurs in data as a whole word.
+func containsWord(data []byte, word string) bool {
+	for offset := 0; ; {
+		idx := bytes.Index(data[offset:], []byte(word))
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(word)
+		if (start == 0 || !characterClass(data[start-1])) && (end == len(data) || !characterClass(data[end])) {
+			return true
+		}
+		offset = end
+	}
+}
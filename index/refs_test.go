@@ -0,0 +1,75 @@
+package index
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestSymbolRefs(t *testing.T) {
+	// definedAt returns doc with a function symbol for the first instance
+	// of name in its content.
+	definedAt := func(doc Document, name string) Document {
+		i := strings.Index(string(doc.Content), name)
+		doc.Symbols = []DocumentSection{{Start: uint32(i), End: uint32(i + len(name))}}
+		doc.SymbolsMetaData = []*zoekt.Symbol{{Sym: name, Kind: "function"}}
+		return doc
+	}
+
+	b := testShardBuilder(t, nil,
+		Document{Name: "other/other.go", Content: []byte("package other\n\nvar s = NewServer()\n")},
+		Document{Name: "cmd/main.go", Content: []byte("package main\n\nimport \"example.com/server\"\n\nvar s = server.NewServer()\n")},
+		definedAt(Document{Name: "server/server.go", Content: []byte("package server\n\nfunc NewServer() {}\n\nfunc init() { NewServer() }\n")}, "NewServer"),
+		Document{Name: "other/config.go", Content: []byte("package other\n\nvar c NewServerConfig\n")},
+	)
+
+	// searchForTest clears the scores we want to check.
+	res, err := searcherForTest(t, b).Search(context.Background(), &query.SymbolRefs{Name: "NewServer"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SortFiles(res.Files)
+
+	var got []string
+	for _, f := range res.Files {
+		for _, lm := range f.LineMatches {
+			got = append(got, f.FileName+":"+strings.TrimSpace(string(lm.Line)))
+		}
+	}
+	// Ranked by the package of the reference: the defining package, then
+	// the importing one, then the rest. The definition itself is not a
+	// reference.
+	want := []string{
+		"server/server.go:func init() { NewServer() }",
+		"cmd/main.go:var s = server.NewServer()",
+		"other/other.go:var s = NewServer()",
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestImportsPackage(t *testing.T) {
+	for _, tc := range []struct {
+		content string
+		want    bool
+	}{
+		{"import \"example.com/server\"\n", true},
+		{"import (\n\t\"fmt\"\n\tsrv \"example.com/server\"\n)\n", true},
+		{"from server import NewServer\n", true},
+		{"use crate::server;\n", true},
+		{"#include \"server.h\"\n", true},
+		{"import \"example.com/servers\"\n", false},
+		{"x := \"server\"\n", false},
+		{"package server\n", false},
+	} {
+		if got := importsPackage([]byte(tc.content), "server"); got != tc.want {
+			t.Errorf("importsPackage(%q) = %v, want %v", tc.content, got, tc.want)
+		}
+	}
+}
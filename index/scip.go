@@ -0,0 +1,140 @@
+package index
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/zoekt/internal/scip"
+)
+
+// scipOccurrences provides the occurrences of a SCIP index by document.
+type scipOccurrences struct {
+	// docs maps relative paths to documents.
+	docs map[string]*scip.Document
+
+	// symbols holds the information about all symbols of the index.
+	symbols map[string]*scip.SymbolInformation
+}
+
+func loadSCIPOccurrences(path string) (*scipOccurrences, error) {
+	idx, err := scip.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &scipOccurrences{
+		docs:    make(map[string]*scip.Document, len(idx.Documents)),
+		symbols: map[string]*scip.SymbolInformation{},
+	}
+	for _, info := range idx.ExternalSymbols {
+		s.symbols[info.Symbol] = info
+	}
+	for _, doc := range idx.Documents {
+		s.docs[doc.RelativePath] = doc
+		for _, info := range doc.Symbols {
+			s.symbols[info.Symbol] = info
+		}
+	}
+	return s, nil
+}
+
+// occurrences returns the occurrences of the document named name with the
+// given content. Occurrences of local symbols are dropped, as are those
+// whose range doesn't fit the content, e.g. because the SCIP index is for a
+// different version of the file.
+func (s *scipOccurrences) occurrences(name string, content []byte) []Occurrence {
+	doc, ok := s.docs[name]
+	if !ok {
+		return nil
+	}
+
+	lineStarts := []int{0}
+	for i, c := range content {
+		if c == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	// offset converts a SCIP position to a byte offset in content.
+	offset := func(line, character int32) (uint32, bool) {
+		if line < 0 || int(line) >= len(lineStarts) || character < 0 {
+			return 0, false
+		}
+		start := lineStarts[line]
+		end := len(content)
+		if int(line)+1 < len(lineStarts) {
+			end = lineStarts[line+1]
+		}
+		n, ok := characterOffset(content[start:end], int(character), doc.PositionEncoding)
+		return uint32(start + n), ok
+	}
+
+	var occs []Occurrence
+	for _, o := range doc.Occurrences {
+		if o.Symbol == "" || scip.IsLocal(o.Symbol) {
+			continue
+		}
+
+		var startLine, startChar, endLine, endChar int32
+		switch len(o.Range) {
+		case 3:
+			startLine, startChar, endLine, endChar = o.Range[0], o.Range[1], o.Range[0], o.Range[2]
+		case 4:
+			startLine, startChar, endLine, endChar = o.Range[0], o.Range[1], o.Range[2], o.Range[3]
+		default:
+			continue
+		}
+		start, ok := offset(startLine, startChar)
+		if !ok {
+			continue
+		}
+		end, ok := offset(endLine, endChar)
+		if !ok || end < start {
+			continue
+		}
+
+		occ := Occurrence{
+			Start:      start,
+			End:        end,
+			Symbol:     o.Symbol,
+			Name:       scip.Name(o.Symbol),
+			Definition: o.IsDefinition(),
+		}
+		if info, ok := s.symbols[o.Symbol]; ok {
+			if info.DisplayName != "" {
+				occ.Name = info.DisplayName
+			}
+			occ.Documentation = strings.Join(info.Documentation, "\n\n")
+		}
+		occs = append(occs, occ)
+	}
+
+	sort.SliceStable(occs, func(i, j int) bool { return occs[i].Start < occs[j].Start })
+	return occs
+}
+
+// characterOffset returns the byte offset within line of the character
+// offset counted in the units of enc. Indexers that don't specify the
+// encoding are assumed to use UTF-16 like LSP does.
+func characterOffset(line []byte, character int, enc scip.PositionEncoding) (int, bool) {
+	if enc == scip.PositionEncodingUTF8 {
+		return character, character <= len(line)
+	}
+
+	i, units := 0, 0
+	for units < character {
+		if i >= len(line) {
+			return 0, false
+		}
+		r, sz := utf8.DecodeRune(line[i:])
+		i += sz
+		if enc == scip.PositionEncodingUTF32 {
+			units++
+		} else {
+			units += utf16.RuneLen(r)
+		}
+	}
+	return i, units == character
+}
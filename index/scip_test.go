@@ -0,0 +1,95 @@
+package index
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt/internal/scip"
+)
+
+func TestSCIPOccurrences(t *testing.T) {
+	const (
+		hello = "scip-go gomod example v1 `example`/hello()."
+		fmtS  = "scip-go gomod std . fmt/Sprintf()."
+	)
+	idx := &scip.Index{
+		Documents: []*scip.Document{{
+			RelativePath:     "main.go",
+			PositionEncoding: scip.PositionEncodingUTF16,
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{2, 5, 10}, Symbol: hello, SymbolRoles: scip.SymbolRoleDefinition},
+				// After the 😀, which is two UTF-16 code units.
+				{Range: []int32{3, 16, 3, 23}, Symbol: fmtS},
+				{Range: []int32{3, 1, 3}, Symbol: "local 0"},
+				// Past the end of the file.
+				{Range: []int32{10, 0, 1}, Symbol: hello},
+			},
+			Symbols: []*scip.SymbolInformation{{
+				Symbol:        hello,
+				Documentation: []string{"```go\nfunc hello()\n```", "hello greets."},
+			}},
+		}},
+		ExternalSymbols: []*scip.SymbolInformation{{Symbol: fmtS, DisplayName: "fmt.Sprintf"}},
+	}
+	path := filepath.Join(t.TempDir(), "index.scip")
+	if err := os.WriteFile(path, idx.Marshal(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := loadSCIPOccurrences(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := "package main\n\nfunc hello() {\n\t_ = \"😀\" + fmt.Sprintf(\"\")\n}\n"
+	got := s.occurrences("main.go", []byte(content))
+	want := []Occurrence{{
+		Start:         19,
+		End:           24,
+		Symbol:        hello,
+		Name:          "hello",
+		Documentation: "```go\nfunc hello()\n```\n\nhello greets.",
+		Definition:    true,
+	}, {
+		Start:  47,
+		End:    54,
+		Symbol: fmtS,
+		Name:   "fmt.Sprintf",
+	}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+	if got := content[want[1].Start:want[1].End]; got != "Sprintf" {
+		t.Errorf("got %q, want Sprintf", got)
+	}
+
+	if got := s.occurrences("other.go", []byte(content)); got != nil {
+		t.Errorf("got %v for document missing from the index", got)
+	}
+}
+
+func TestCharacterOffset(t *testing.T) {
+	line := []byte("a😀b\n")
+	for _, tc := range []struct {
+		character int
+		enc       scip.PositionEncoding
+		want      int
+		ok        bool
+	}{
+		{2, scip.PositionEncodingUTF8, 2, true},
+		{3, scip.PositionEncodingUTF16, 5, true},
+		{2, scip.PositionEncodingUTF32, 5, true},
+		{2, scip.PositionEncodingUTF16, 0, false}, // within the surrogate pair
+		{3, scip.PositionEncodingUnspecified, 5, true},
+		{10, scip.PositionEncodingUTF8, 0, false},
+		{10, scip.PositionEncodingUTF32, 0, false},
+	} {
+		got, ok := characterOffset(line, tc.character, tc.enc)
+		if ok != tc.ok || (ok && got != tc.want) {
+			t.Errorf("characterOffset(%d, %d) = %d, %v, want %d, %v", tc.character, tc.enc, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -16,17 +16,31 @@ package index
 
 import (
 	"encoding/binary"
+	"fmt"
+	"hash/crc32"
 	"io"
 	"log"
+	"math"
 )
 
 var _ = log.Println
 
-// writer is an io.Writer that keeps track of errors and offsets
+// writer is an io.Writer that keeps track of errors, offsets and the
+// checksums of sections.
 type writer struct {
 	err error
 	w   io.Writer
-	off uint32
+	off uint64
+
+	// crc is the checksum of the data written since crcOff, the start of
+	// the last section.
+	crc    uint32
+	crcOff uint64
+
+	// checksums holds the checksums of the sections written so far.
+	// Sections written inside of other sections have a checksum, but the
+	// outer ones don't.
+	checksums map[simpleSection]uint32
 }
 
 func (w *writer) Write(b []byte) (int, error) {
@@ -36,11 +50,12 @@ func (w *writer) Write(b []byte) (int, error) {
 
 	var n int
 	n, w.err = w.w.Write(b)
-	w.off += uint32(n)
+	w.off += uint64(n)
+	w.crc = crc32.Update(w.crc, castagnoliTable, b[:n])
 	return n, w.err
 }
 
-func (w *writer) Off() uint32 { return w.off }
+func (w *writer) Off() uint64 { return w.off }
 
 func (w *writer) B(b byte) {
 	s := []byte{b}
@@ -73,21 +88,38 @@ func (w *writer) String(s string) {
 
 func (s *simpleSection) start(w *writer) {
 	s.off = w.Off()
+	w.crc = 0
+	w.crcOff = s.off
 }
 
 func (s *simpleSection) end(w *writer) {
-	s.sz = w.Off() - s.off
+	sz := w.Off() - s.off
+	if sz > math.MaxUint32 && w.err == nil {
+		w.err = fmt.Errorf("section at offset %d has %d bytes, want at most %d", s.off, sz, uint32(math.MaxUint32))
+	}
+	s.sz = uint32(sz)
+
+	if w.crcOff == s.off {
+		if w.checksums == nil {
+			w.checksums = map[simpleSection]uint32{}
+		}
+		w.checksums[*s] = w.crc
+	}
 }
 
 // section is a range of bytes in the index file.
 type section interface {
-	read(*reader) error
+	// read reads the section. If wide is set, the section was written with
+	// 64-bit offsets.
+	read(r *reader, wide bool) error
 	// skip advances over the data in the section without reading it.
 	// NOTE: the section will not contain valid data after this call, and it should not be used.
-	skip(*reader) error
+	skip(r *reader, wide bool) error
 	write(*writer)
 	// kind encodes whether the section is simple or compound, and is used in serialization
 	kind() sectionKind
+	// wide returns true if the section must be written with 64-bit offsets.
+	wide() bool
 }
 
 type sectionKind int
@@ -96,11 +128,28 @@ const (
 	sectionKindSimple       sectionKind = 0
 	sectionKindCompound     sectionKind = 1
 	sectionKindCompoundLazy sectionKind = 2
+
+	// sectionKindWide is added to the kind of sections that start beyond 4
+	// GiB. Their offsets are written as 64-bit integers, and the index of
+	// compound sections holds offsets relative to the start of the data
+	// instead of absolute ones. Files smaller than 4 GiB don't have wide
+	// sections, so they can be read by older versions.
+	sectionKindWide sectionKind = 8
 )
 
-// simpleSection is a simple range of bytes.
+// tocKind returns the kind written to the TOC for s.
+func tocKind(s section) sectionKind {
+	if s.wide() {
+		return s.kind() + sectionKindWide
+	}
+	return s.kind()
+}
+
+// simpleSection is a simple range of bytes. Sections may start anywhere in
+// the file, but hold at most 4 GiB: the offsets within them, and the rune
+// offsets into the content in the posting lists, are uint32.
 type simpleSection struct {
-	off uint32
+	off uint64
 	sz  uint32
 }
 
@@ -108,9 +157,19 @@ func (s *simpleSection) kind() sectionKind {
 	return sectionKindSimple
 }
 
-func (s *simpleSection) read(r *reader) error {
+func (s *simpleSection) wide() bool {
+	return s.off > math.MaxUint32
+}
+
+func (s *simpleSection) read(r *reader, wide bool) error {
 	var err error
-	s.off, err = r.U32()
+	if wide {
+		s.off, err = r.U64()
+	} else {
+		var off uint32
+		off, err = r.U32()
+		s.off = uint64(off)
+	}
 	if err != nil {
 		return err
 	}
@@ -118,18 +177,21 @@ func (s *simpleSection) read(r *reader) error {
 	return err
 }
 
-func (s *simpleSection) skip(r *reader) error {
-	var err error
-	_, err = r.U32()
-	if err != nil {
-		return err
-	}
-	_, err = r.U32()
-	return err
+func (s *simpleSection) skip(r *reader, wide bool) error {
+	var tmp simpleSection
+	return tmp.read(r, wide)
 }
 
 func (s *simpleSection) write(w *writer) {
-	w.U32(s.off)
+	s.writeOffsets(w, s.wide())
+}
+
+func (s *simpleSection) writeOffsets(w *writer, wide bool) {
+	if wide {
+		w.U64(s.off)
+	} else {
+		w.U32(uint32(s.off))
+	}
 	w.U32(s.sz)
 }
 
@@ -138,54 +200,70 @@ func (s *simpleSection) write(w *writer) {
 type compoundSection struct {
 	data simpleSection
 
+	// offsets holds the offsets of the items, which are absolute, or
+	// relative to data.off if isWide is set.
 	offsets []uint32
 	index   simpleSection
+
+	isWide bool
 }
 
 func (s *compoundSection) kind() sectionKind {
 	return sectionKindCompound
 }
 
+func (s *compoundSection) wide() bool {
+	return s.isWide
+}
+
 func (s *compoundSection) start(w *writer) {
 	s.data.start(w)
 }
 
 func (s *compoundSection) end(w *writer) {
 	s.data.end(w)
+
+	// If the section ends beyond 4 GiB, the absolute offsets of its items
+	// may not fit in 32 bits.
+	s.isWide = w.Off() > math.MaxUint32
 	s.index.start(w)
 	for _, o := range s.offsets {
+		if !s.isWide {
+			o += uint32(s.data.off)
+		}
 		w.U32(o)
 	}
 	s.index.end(w)
 }
 
 func (s *compoundSection) addItem(w *writer, item []byte) {
-	s.offsets = append(s.offsets, w.Off())
+	s.offsets = append(s.offsets, uint32(w.Off()-s.data.off))
 	w.Write(item)
 }
 
 func (s *compoundSection) write(w *writer) {
-	s.data.write(w)
-	s.index.write(w)
+	s.data.writeOffsets(w, s.isWide)
+	s.index.writeOffsets(w, s.isWide)
 }
 
-func (s *compoundSection) read(r *reader) error {
-	if err := s.data.read(r); err != nil {
+func (s *compoundSection) read(r *reader, wide bool) error {
+	if err := s.data.read(r, wide); err != nil {
 		return err
 	}
-	if err := s.index.read(r); err != nil {
+	if err := s.index.read(r, wide); err != nil {
 		return err
 	}
+	s.isWide = wide
 	var err error
 	s.offsets, err = readSectionU32(r.r, s.index)
 	return err
 }
 
-func (s *compoundSection) skip(r *reader) error {
-	if err := s.data.skip(r); err != nil {
+func (s *compoundSection) skip(r *reader, wide bool) error {
+	if err := s.data.skip(r, wide); err != nil {
 		return err
 	}
-	if err := s.index.read(r); err != nil {
+	if err := s.index.read(r, wide); err != nil {
 		return err
 	}
 
@@ -193,6 +271,15 @@ func (s *compoundSection) skip(r *reader) error {
 	return err
 }
 
+// base returns the offset that the offsets in the index of s are relative
+// to.
+func (s *compoundSection) base() uint64 {
+	if s.isWide {
+		return s.data.off
+	}
+	return 0
+}
+
 // relativeIndex returns the relative offsets of the items (first
 // element is 0), plus a final marking the end of the last item.
 func (s *compoundSection) relativeIndex() []uint32 {
@@ -214,11 +301,12 @@ func (s *lazyCompoundSection) kind() sectionKind {
 	return sectionKindCompoundLazy
 }
 
-func (s *lazyCompoundSection) read(r *reader) error {
+func (s *lazyCompoundSection) read(r *reader, wide bool) error {
 	// We do the same thing compoundSection.read does, except we don't read the
 	// offsets.
-	if err := s.data.read(r); err != nil {
+	if err := s.data.read(r, wide); err != nil {
 		return err
 	}
-	return s.index.read(r)
+	s.isWide = wide
+	return s.index.read(r, wide)
 }
@@ -171,6 +171,9 @@ type ShardBuilder struct {
 	indexFormatVersion int
 	featureVersion     int
 
+	// compressContent stores the file contents in compressed blocks.
+	compressContent bool
+
 	contentStrings  []*searchableString
 	nameStrings     []*searchableString
 	docSections     [][]DocumentSection
@@ -209,6 +212,29 @@ type ShardBuilder struct {
 
 	categories []byte
 
+	// commits holds the encoded commit metadata of all documents, see
+	// appendCommit. It is only written if hasCommits is set.
+	commits    []byte
+	hasCommits bool
+
+	// blameCommits holds the distinct commits referenced by blame, indexed
+	// by blameCommitIndex. blame holds the encoded blame hunks of all
+	// documents, see addBlame.
+	blameCommits     []zoekt.Blame
+	blameCommitIndex map[string]uint32
+	blame            [][]byte
+
+	// scipSymbols holds the distinct symbols referenced by occurrences,
+	// indexed by scipSymbolIndex. occurrences holds the encoded occurrences
+	// of all documents, see addOccurrences.
+	scipSymbols     []scipSymbol
+	scipSymbolIndex map[string]uint32
+	occurrences     [][]byte
+
+	// fingerprints holds the MinHash signatures of all documents, see
+	// addFingerprint.
+	fingerprints []byte
+
 	// IndexTime will be used as the time if non-zero. Otherwise
 	// time.Now(). This is useful for doing reproducible builds in tests.
 	IndexTime time.Time
@@ -287,12 +313,14 @@ func newShardBuilder() *ShardBuilder {
 		indexFormatVersion: IndexFormatVersion,
 		featureVersion:     FeatureVersion,
 
-		contentPostings: newPostingsBuilder(),
-		namePostings:    newPostingsBuilder(),
-		fileEndSymbol:   []uint32{0},
-		symIndex:        make(map[string]uint32),
-		symKindIndex:    make(map[string]uint32),
-		languageMap:     make(map[string]uint16),
+		contentPostings:  newPostingsBuilder(),
+		namePostings:     newPostingsBuilder(),
+		fileEndSymbol:    []uint32{0},
+		symIndex:         make(map[string]uint32),
+		symKindIndex:     make(map[string]uint32),
+		languageMap:      make(map[string]uint16),
+		blameCommitIndex: make(map[string]uint32),
+		scipSymbolIndex:  make(map[string]uint32),
 	}
 }
 
@@ -428,6 +456,22 @@ func (b *ShardBuilder) Add(doc Document) error {
 		doc.Content = []byte(notIndexedMarker + doc.SkipReason.explanation())
 		doc.Symbols = nil
 		doc.SymbolsMetaData = nil
+		doc.Blame = nil
+		doc.Occurrences = nil
+		if doc.Commit != nil {
+			// The message is gone along with the content.
+			c := *doc.Commit
+			c.MessageStart, c.MessageEnd = 0, 0
+			doc.Commit = &c
+		}
+	}
+	if c := doc.Commit; c != nil && (c.MessageStart > c.MessageEnd || c.MessageEnd > uint32(len(doc.Content))) {
+		return fmt.Errorf("commit message goes past end of content")
+	}
+	for _, o := range doc.Occurrences {
+		if o.Start > o.End || o.End > uint32(len(doc.Content)) {
+			return fmt.Errorf("occurrence of %s goes past end of content", o.Symbol)
+		}
 	}
 
 	DetermineLanguageIfUnknown(&doc)
@@ -513,6 +557,13 @@ func (b *ShardBuilder) Add(doc Document) error {
 	}
 	b.categories = append(b.categories, category)
 
+	b.commits = appendCommit(b.commits, doc.Commit)
+	b.hasCommits = b.hasCommits || doc.Commit != nil
+
+	b.addBlame(doc.Blame)
+	b.addOccurrences(doc.Occurrences)
+	b.addFingerprint(&doc)
+
 	return nil
 }
 
@@ -0,0 +1,305 @@
+package index
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
+
+// structuralPattern is a compiled comby-style pattern for struct: queries.
+// Patterns are literal text with holes:
+//
+//   - :[name] lazily matches balanced text. Parentheses, brackets and braces
+//     must be balanced and string literals are skipped as a whole. Outside of
+//     delimiters the hole doesn't match newlines.
+//   - :[[name]] matches an identifier, ie. a run of word characters.
+//   - whitespace matches one or more whitespace characters.
+//
+// Holes with the same name must match the same text, except for the
+// anonymous hole :[_].
+type structuralPattern struct {
+	source string
+	elems  []structuralElem
+}
+
+type structuralElemKind int
+
+const (
+	structuralLiteral structuralElemKind = iota
+	structuralSpace
+	structuralHole
+	structuralIdentHole
+)
+
+type structuralElem struct {
+	kind structuralElemKind
+
+	// text is the literal text, or the name of a hole.
+	text string
+}
+
+func parseStructuralPattern(pattern string) (*structuralPattern, error) {
+	p := &structuralPattern{source: pattern}
+	var lit []byte
+	flush := func() {
+		if len(lit) > 0 {
+			p.elems = append(p.elems, structuralElem{kind: structuralLiteral, text: string(lit)})
+			lit = nil
+		}
+	}
+
+	in := []byte(pattern)
+	for len(in) > 0 {
+		if isStructuralSpace(in[0]) {
+			flush()
+			for len(in) > 0 && isStructuralSpace(in[0]) {
+				in = in[1:]
+			}
+			p.elems = append(p.elems, structuralElem{kind: structuralSpace})
+			continue
+		}
+
+		if name, n, ok := parseStructuralHole(in, ":[[", "]]"); ok {
+			flush()
+			p.elems = append(p.elems, structuralElem{kind: structuralIdentHole, text: name})
+			in = in[n:]
+			continue
+		}
+		if name, n, ok := parseStructuralHole(in, ":[", "]"); ok {
+			flush()
+			p.elems = append(p.elems, structuralElem{kind: structuralHole, text: name})
+			in = in[n:]
+			continue
+		}
+
+		lit = append(lit, in[0])
+		in = in[1:]
+	}
+	flush()
+
+	// Leading and trailing whitespace doesn't constrain matches.
+	for len(p.elems) > 0 && p.elems[0].kind == structuralSpace {
+		p.elems = p.elems[1:]
+	}
+	for len(p.elems) > 0 && p.elems[len(p.elems)-1].kind == structuralSpace {
+		p.elems = p.elems[:len(p.elems)-1]
+	}
+	if len(p.elems) == 0 {
+		return nil, fmt.Errorf("empty structural pattern %q", pattern)
+	}
+	return p, nil
+}
+
+// parseStructuralHole parses a hole delimited by open and close at the start
+// of in, returning its name and length.
+func parseStructuralHole(in []byte, open, close string) (string, int, bool) {
+	if !bytes.HasPrefix(in, []byte(open)) {
+		return "", 0, false
+	}
+	end := bytes.Index(in[len(open):], []byte(close))
+	if end < 0 {
+		return "", 0, false
+	}
+	name := in[len(open) : len(open)+end]
+	for _, c := range name {
+		if !characterClass(c) {
+			return "", 0, false
+		}
+	}
+	return string(name), len(open) + end + len(close), true
+}
+
+// literals returns the literal parts of the pattern, which every match
+// contains.
+func (p *structuralPattern) literals() []string {
+	var lits []string
+	for _, e := range p.elems {
+		if e.kind == structuralLiteral {
+			lits = append(lits, e.text)
+		}
+	}
+	return lits
+}
+
+// findAll returns the non-overlapping matches of the pattern in data as
+// start and end offsets.
+func (p *structuralPattern) findAll(data []byte) [][2]int {
+	var (
+		matches [][2]int
+		env     = map[string]string{}
+	)
+	for i := 0; i < len(data); {
+		// Skip to the next occurrence of a leading literal.
+		if first := p.elems[0]; first.kind == structuralLiteral {
+			idx := bytes.Index(data[i:], []byte(first.text))
+			if idx < 0 {
+				break
+			}
+			i += idx
+		}
+
+		// Don't start matches in the middle of a word.
+		if i > 0 && characterClass(data[i-1]) && characterClass(data[i]) {
+			i++
+			continue
+		}
+
+		clear(env)
+		if end, ok := p.match(data, i, 0, env); ok && end > i {
+			matches = append(matches, [2]int{i, end})
+			i = end
+			continue
+		}
+		i++
+	}
+	return matches
+}
+
+// match matches the elements of the pattern starting at elem against data
+// starting at i, returning the end of the match.
+func (p *structuralPattern) match(data []byte, i, elem int, env map[string]string) (int, bool) {
+	if elem == len(p.elems) {
+		// Don't end matches in the middle of a word.
+		if i > 0 && i < len(data) && characterClass(data[i-1]) && characterClass(data[i]) {
+			return 0, false
+		}
+		return i, true
+	}
+
+	e := p.elems[elem]
+	switch e.kind {
+	case structuralLiteral:
+		if !bytes.HasPrefix(data[i:], []byte(e.text)) {
+			return 0, false
+		}
+		return p.match(data, i+len(e.text), elem+1, env)
+
+	case structuralSpace:
+		j := i
+		for j < len(data) && isStructuralSpace(data[j]) {
+			j++
+		}
+		if j == i {
+			return 0, false
+		}
+		return p.match(data, j, elem+1, env)
+
+	case structuralIdentHole:
+		j := i
+		for j < len(data) && characterClass(data[j]) {
+			j++
+		}
+		if j == i {
+			return 0, false
+		}
+		return p.matchHole(data, i, j, elem, env)
+
+	default:
+		for j := i; ; {
+			if end, ok := p.matchHole(data, i, j, elem, env); ok {
+				return end, true
+			}
+			next, ok := structuralUnit(data, j)
+			if !ok {
+				return 0, false
+			}
+			j = next
+		}
+	}
+}
+
+// matchHole binds the hole at elem to data[i:j] and matches the rest of the
+// pattern.
+func (p *structuralPattern) matchHole(data []byte, i, j, elem int, env map[string]string) (int, bool) {
+	name := p.elems[elem].text
+	if name == "_" || name == "" {
+		return p.match(data, j, elem+1, env)
+	}
+
+	if bound, ok := env[name]; ok {
+		if bound != string(data[i:j]) {
+			return 0, false
+		}
+		return p.match(data, j, elem+1, env)
+	}
+
+	env[name] = string(data[i:j])
+	end, ok := p.match(data, j, elem+1, env)
+	if !ok {
+		delete(env, name)
+	}
+	return end, ok
+}
+
+// structuralUnit returns the end of the unit of text starting at i that a
+// hole can match: a balanced group, a string literal or a single character.
+func structuralUnit(data []byte, i int) (int, bool) {
+	if i >= len(data) {
+		return 0, false
+	}
+
+	switch c := data[i]; c {
+	case '(', '[', '{':
+		return structuralGroupEnd(data, i)
+	case ')', ']', '}', '\n':
+		return 0, false
+	case '"', '\'', '`':
+		if end, ok := structuralStringEnd(data, i); ok {
+			return end, true
+		}
+	}
+	_, sz := utf8.DecodeRune(data[i:])
+	return i + sz, true
+}
+
+// structuralGroupEnd returns the end of the group opened at data[i].
+func structuralGroupEnd(data []byte, i int) (int, bool) {
+	var stack []byte
+	for j := i; j < len(data); {
+		switch c := data[j]; c {
+		case '(':
+			stack = append(stack, ')')
+		case '[':
+			stack = append(stack, ']')
+		case '{':
+			stack = append(stack, '}')
+		case ')', ']', '}':
+			if stack[len(stack)-1] != c {
+				return 0, false
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return j + 1, true
+			}
+		case '"', '\'', '`':
+			if end, ok := structuralStringEnd(data, j); ok {
+				j = end
+				continue
+			}
+		}
+		j++
+	}
+	return 0, false
+}
+
+// structuralStringEnd returns the end of the string literal opened at
+// data[i]. Apart from raw strings quoted with backticks, string literals
+// end on the line they start.
+func structuralStringEnd(data []byte, i int) (int, bool) {
+	quote := data[i]
+	for j := i + 1; j < len(data); j++ {
+		switch c := data[j]; {
+		case c == '\\' && quote != '`':
+			j++
+		case c == quote:
+			return j + 1, true
+		case c == '\n' && quote != '`':
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+func isStructuralSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,102 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt/query"
+)
+
+func TestStructuralPattern(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		data    string
+		want    []string
+	}{
+		{
+			pattern: `foo(:[a], ":[b]")`,
+			data:    `foo(x, "y"); foo(x, y); foo(bar(1, 2), "z")`,
+			want:    []string{`foo(x, "y")`, `foo(bar(1, 2), "z")`},
+		},
+		{
+			// Holes skip balanced groups and string literals.
+			pattern: `f(:[a])`,
+			data:    `f(g(")"), [1, 2]) + f()`,
+			want:    []string{`f(g(")"), [1, 2])`, `f()`},
+		},
+		{
+			// Whitespace matches any amount of whitespace.
+			pattern: `if :[c] { return nil }`,
+			data:    "if err != nil {\n\treturn nil\n}",
+			want:    []string{"if err != nil {\n\treturn nil\n}"},
+		},
+		{
+			// Holes with the same name match the same text.
+			pattern: `:[[x]] = :[[x]]`,
+			data:    "a = b\nc = c\n",
+			want:    []string{"c = c"},
+		},
+		{
+			// The anonymous hole doesn't bind.
+			pattern: `:[[_]] = :[[_]]`,
+			data:    "a = b\n",
+			want:    []string{"a = b"},
+		},
+		{
+			// Matches don't start or end within words.
+			pattern: `foo(:[_])`,
+			data:    "barfoo(1) foo(2)",
+			want:    []string{"foo(2)"},
+		},
+		{
+			// Outside of delimiters holes don't match newlines.
+			pattern: `a :[x] b`,
+			data:    "a 1\n2 b\na 3 b",
+			want:    []string{"a 3 b"},
+		},
+		{
+			// Unbalanced text doesn't match.
+			pattern: `f(:[a])`,
+			data:    "f(g(1)",
+			want:    nil,
+		},
+	} {
+		p, err := parseStructuralPattern(tc.pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, m := range p.findAll([]byte(tc.data)) {
+			got = append(got, tc.data[m[0]:m[1]])
+		}
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("%s on %q: mismatch (-want +got):\n%s", tc.pattern, tc.data, d)
+		}
+	}
+
+	if _, err := parseStructuralPattern(" \t"); err == nil {
+		t.Error("got no error for empty pattern")
+	}
+}
+
+func TestStructuralSearch(t *testing.T) {
+	b := testShardBuilder(t, nil,
+		Document{Name: "a.go", Content: []byte("log.Printf(\"%d\", x)\nlog.Printf(format, x)\n")},
+		Document{Name: "b.go", Content: []byte("fmt.Println(x)\n")},
+	)
+
+	res := searchForTest(t, b, &query.Structural{Pattern: `log.Printf(":[f]", :[args])`})
+
+	var got []string
+	for _, f := range res.Files {
+		for _, lm := range f.LineMatches {
+			for _, fr := range lm.LineFragments {
+				got = append(got, f.FileName+":"+string(lm.Line[fr.LineOffset:fr.LineOffset+fr.MatchLength]))
+			}
+		}
+	}
+	if d := cmp.Diff([]string{`a.go:log.Printf("%d", x)`}, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
@@ -43,7 +43,8 @@ const IndexFormatVersion = 16
 // 10: Compound shards; more flexible TOC format.
 // 11: Bloom filters for file names & contents
 // 12: go-enry for identifying file languages
-const FeatureVersion = 12
+// 13: Optional block-compressed file contents
+const FeatureVersion = 13
 
 // WriteMinFeatureVersion and ReadMinFeatureVersion constrain forwards and backwards
 // compatibility. For example, if a new way to encode filenameNgrams on disk is
@@ -101,6 +102,21 @@ type indexTOC struct {
 	reposIDsBitmap simpleSection
 
 	ranks simpleSection
+
+	commits simpleSection
+
+	blameCommits simpleSection
+	blame        compoundSection
+
+	scipSymbols simpleSection
+	occurrences compoundSection
+
+	fingerprints simpleSection
+
+	contentBlocks     compoundSection
+	contentBoundaries simpleSection
+
+	sectionChecksums simpleSection
 }
 
 func (t *indexTOC) sections() []section {
@@ -185,6 +201,15 @@ func (t *indexTOC) sectionsTaggedList() []taggedSection {
 		{"runeDocSections", &t.runeDocSections},
 		{"repos", &t.repos},
 		{"reposIDsBitmap", &t.reposIDsBitmap},
+		{"commits", &t.commits},
+		{"blameCommits", &t.blameCommits},
+		{"blame", &t.blame},
+		{"scipSymbols", &t.scipSymbols},
+		{"occurrences", &t.occurrences},
+		{"fingerprints", &t.fingerprints},
+		{"contentBlocks", &t.contentBlocks},
+		{"contentBoundaries", &t.contentBoundaries},
+		{"sectionChecksums", &t.sectionChecksums},
 
 		// We no longer write these sections, but we still return them here to avoid
 		// warnings about unknown sections.
@@ -44,11 +44,23 @@ func (w *writer) writeTOC(toc *indexTOC) {
 	secs := toc.sectionsTaggedList()
 	for _, s := range secs {
 		w.String(s.tag)
-		w.Varint(uint32(s.sec.kind()))
+		w.Varint(uint32(tocKind(s.sec)))
 		s.sec.write(w)
 	}
 }
 
+// wideTrailerMagic marks the trailer of files whose TOC starts beyond 4 GiB.
+// Their trailer is the 64-bit offset and the size of the TOC, followed by
+// the magic. Other files end with the 32-bit offset and the size of the TOC.
+const wideTrailerMagic = 0xffffffff
+
+func (w *writer) writeTrailer(tocSection *simpleSection) {
+	tocSection.write(w)
+	if tocSection.wide() {
+		w.U32(wideTrailerMagic)
+	}
+}
+
 func (s *compoundSection) writeStrings(w *writer, strs []*searchableString) {
 	s.start(w)
 	for _, f := range strs {
@@ -109,15 +121,24 @@ func writePostings(w *writer, s *postingsBuilder, ngramText *simpleSection,
 }
 
 func (b *ShardBuilder) Write(out io.Writer) error {
-	next := b.indexFormatVersion == NextIndexFormatVersion
-
 	buffered := bufio.NewWriterSize(out, 1<<20)
 	defer buffered.Flush()
 
-	w := &writer{w: buffered}
+	return b.write(&writer{w: buffered})
+}
+
+func (b *ShardBuilder) write(w *writer) error {
+	next := b.indexFormatVersion == NextIndexFormatVersion
+
 	toc := indexTOC{}
 
-	toc.fileContents.writeStrings(w, b.contentStrings)
+	if b.compressContent {
+		toc.fileContents.start(w)
+		toc.fileContents.end(w)
+		writeContentBlocks(w, &toc.contentBlocks, &toc.contentBoundaries, b.contentStrings)
+	} else {
+		toc.fileContents.writeStrings(w, b.contentStrings)
+	}
 	toc.newlines.start(w)
 	for _, f := range b.contentStrings {
 		toc.newlines.addItem(w, toSizedDeltas(newLinesIndices(f.data)))
@@ -173,6 +194,44 @@ func (b *ShardBuilder) Write(out io.Writer) error {
 	w.Write(b.categories)
 	toc.categories.end(w)
 
+	toc.commits.start(w)
+	if b.hasCommits {
+		w.Write(b.commits)
+	}
+	toc.commits.end(w)
+
+	toc.blameCommits.start(w)
+	for i := range b.blameCommits {
+		w.Write(appendBlameCommit(nil, &b.blameCommits[i]))
+	}
+	toc.blameCommits.end(w)
+
+	toc.blame.start(w)
+	if len(b.blameCommits) > 0 {
+		for _, item := range b.blame {
+			toc.blame.addItem(w, item)
+		}
+	}
+	toc.blame.end(w)
+
+	toc.scipSymbols.start(w)
+	for i := range b.scipSymbols {
+		w.Write(appendSCIPSymbol(nil, &b.scipSymbols[i]))
+	}
+	toc.scipSymbols.end(w)
+
+	toc.occurrences.start(w)
+	if len(b.scipSymbols) > 0 {
+		for _, item := range b.occurrences {
+			toc.occurrences.addItem(w, item)
+		}
+	}
+	toc.occurrences.end(w)
+
+	toc.fingerprints.start(w)
+	w.Write(b.fingerprints)
+	toc.fingerprints.end(w)
+
 	toc.runeDocSections.start(w)
 	w.Write(marshalDocSections(b.runeDocSections))
 	toc.runeDocSections.end(w)
@@ -194,11 +253,16 @@ func (b *ShardBuilder) Write(out io.Writer) error {
 		indexTime = time.Now().UTC()
 	}
 
+	minReaderVersion := WriteMinFeatureVersion
+	if b.compressContent {
+		minReaderVersion = contentBlocksMinReaderVersion
+	}
+
 	if err := b.writeJSON(&zoekt.IndexMetadata{
 		IndexFormatVersion:    b.indexFormatVersion,
 		IndexTime:             indexTime,
 		IndexFeatureVersion:   b.featureVersion,
-		IndexMinReaderVersion: WriteMinFeatureVersion,
+		IndexMinReaderVersion: minReaderVersion,
 		PlainASCII:            b.contentPostings.isPlainASCII && b.namePostings.isPlainASCII,
 		LanguageMap:           b.languageMap,
 		ZoektVersion:          Version,
@@ -220,12 +284,17 @@ func (b *ShardBuilder) Write(out io.Writer) error {
 		}
 	}
 
+	checksums := encodeSectionChecksums(&toc, w)
+	toc.sectionChecksums.start(w)
+	w.Write(checksums)
+	toc.sectionChecksums.end(w)
+
 	var tocSection simpleSection
 
 	tocSection.start(w)
 	w.writeTOC(&toc)
 	tocSection.end(w)
-	tocSection.write(w)
+	w.writeTrailer(&tocSection)
 	return w.err
 }
 
@@ -0,0 +1,152 @@
+// Package fswatch implements the watch mode shared by the indexers: it
+// watches directory trees with fsnotify and reindexes once changes settle.
+package fswatch
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Loop reindexes after events of Watcher, once no further relevant events
+// arrived for Debounce. At most one reindex runs at a time; events during a
+// reindex schedule another one after it.
+//
+// The callbacks run on the goroutine calling Run, so they may share state
+// without locking.
+type Loop struct {
+	Watcher  *fsnotify.Watcher
+	Debounce time.Duration
+
+	// Event handles an event of Watcher, such as watching a new directory.
+	// It returns why the event requires a reindex, or "" if it doesn't.
+	Event func(fsnotify.Event) string
+
+	// Start is called when a reindex starts. It returns the function doing
+	// the reindex, which runs on its own goroutine.
+	Start func() func() error
+
+	// Done, if not nil, is called with the result of a reindex.
+	Done func(error)
+}
+
+// Run handles events until Watcher is closed or the process is interrupted.
+func (l *Loop) Run() error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+
+	doneCh := make(chan error, 1)
+
+	var (
+		timer         *time.Timer
+		timerCh       <-chan time.Time
+		pending       bool
+		pendingReason string
+		running       bool
+	)
+
+	schedule := func() {
+		if timer == nil {
+			timer = time.NewTimer(l.Debounce)
+		} else {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(l.Debounce)
+		}
+		timerCh = timer.C
+	}
+
+	for {
+		select {
+		case event, ok := <-l.Watcher.Events:
+			if !ok {
+				return nil
+			}
+			reason := l.Event(event)
+			if reason == "" {
+				continue
+			}
+			if !pending {
+				pendingReason = reason
+			}
+			pending = true
+			schedule()
+
+		case err, ok := <-l.Watcher.Errors:
+			if !ok {
+				return nil
+			}
+			if err != nil {
+				log.Printf("watch error: %v", err)
+			}
+
+		case <-timerCh:
+			timerCh = nil
+			if pending && !running {
+				log.Printf("detected changes, reindexing (%s)", pendingReason)
+				pending = false
+				pendingReason = ""
+				running = true
+				reindex := l.Start()
+				go func() {
+					doneCh <- reindex()
+				}()
+			}
+
+		case err := <-doneCh:
+			running = false
+			if err != nil {
+				log.Printf("reindex failed: %v", err)
+			} else {
+				log.Printf("reindex complete")
+			}
+			if l.Done != nil {
+				l.Done(err)
+			}
+			if pending {
+				schedule()
+			}
+
+		case <-sigCh:
+			log.Printf("stopping watch mode")
+			return nil
+		}
+	}
+}
+
+// AddTree watches dir and all its subdirectories, except the trees below
+// directories for which skip returns true. skip may be nil.
+func AddTree(watcher *fsnotify.Watcher, dir string, skip func(path string) bool) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if skip != nil && skip(path) {
+			return filepath.SkipDir
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("adding watch for %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// Within returns true if path is dir or below it.
+func Within(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,100 @@
+package fswatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestLoop(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "sub", "skipped"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	if err := AddTree(watcher, dir, func(path string) bool {
+		return filepath.Base(path) == "skipped"
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var events []string
+	startCh := make(chan []string, 10)
+	doneCh := make(chan error, 10)
+	loop := &Loop{
+		Watcher:  watcher,
+		Debounce: 100 * time.Millisecond,
+		Event: func(event fsnotify.Event) string {
+			events = append(events, filepath.Base(event.Name))
+			return event.String()
+		},
+		Start: func() func() error {
+			startCh <- events
+			events = nil
+			return func() error { return nil }
+		},
+		Done: func(err error) { doneCh <- err },
+	}
+	runErr := make(chan error, 1)
+	go func() { runErr <- loop.Run() }()
+
+	for _, name := range []string{"sub/a", "sub/skipped/b", "c"} {
+		if err := os.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), []byte("x"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case got := <-startCh:
+		seen := map[string]bool{}
+		for _, name := range got {
+			seen[name] = true
+		}
+		if !seen["a"] || !seen["c"] || seen["b"] {
+			t.Errorf("got events for %v, want a and c", got)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("reindex didn't start")
+	}
+	if err := <-doneCh; err != nil {
+		t.Fatal(err)
+	}
+
+	// The writes were debounced into a single reindex.
+	select {
+	case got := <-startCh:
+		t.Errorf("got second reindex for %v", got)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	watcher.Close()
+	if err := <-runErr; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	for _, tt := range []struct {
+		path, dir string
+		want      bool
+	}{
+		{path: "/a", dir: "/a", want: true},
+		{path: "/a/b", dir: "/a", want: true},
+		{path: "/ab", dir: "/a"},
+		{path: "/", dir: "/a"},
+		{path: "/a/../b", dir: "/a"},
+	} {
+		if got := Within(filepath.FromSlash(tt.path), filepath.FromSlash(tt.dir)); got != tt.want {
+			t.Errorf("Within(%q, %q) = %t, want %t", tt.path, tt.dir, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,358 @@
+// Package highlight classifies the tokens of source code for syntax
+// highlighting. Its lexers only know the comments, string literals and
+// keywords of each language, which is enough for consistent highlighting of
+// search results without running a parser.
+package highlight
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+// stringSyntax describes the delimiters of a kind of string literal.
+type stringSyntax struct {
+	open, close string
+
+	// raw strings have no backslash escapes.
+	raw bool
+
+	// multiline strings may contain newlines. Other strings end at the end
+	// of the line if they aren't closed.
+	multiline bool
+}
+
+type lexer struct {
+	lineComments  []string
+	blockComments [][2]string
+
+	// strings are tried in order, so longer delimiters that start with a
+	// shorter one must come first.
+	strings []stringSyntax
+
+	keywords map[string]bool
+
+	// identRunes are the characters besides letters, digits and '_' that
+	// identifiers can contain.
+	identRunes string
+}
+
+func words(ws ...string) map[string]bool {
+	m := make(map[string]bool, len(ws))
+	for _, w := range ws {
+		m[w] = true
+	}
+	return m
+}
+
+var (
+	cStrings = []stringSyntax{{open: `"`, close: `"`}, {open: `'`, close: `'`}}
+	cComment = [][2]string{{"/*", "*/"}}
+
+	cKeywords = []string{
+		"auto", "break", "case", "char", "const", "continue", "default", "do",
+		"double", "else", "enum", "extern", "float", "for", "goto", "if",
+		"inline", "int", "long", "register", "restrict", "return", "short",
+		"signed", "sizeof", "static", "struct", "switch", "typedef", "union",
+		"unsigned", "void", "volatile", "while", "NULL", "true", "false",
+	}
+
+	jsKeywords = []string{
+		"async", "await", "break", "case", "catch", "class", "const",
+		"continue", "debugger", "default", "delete", "do", "else", "export",
+		"extends", "false", "finally", "for", "from", "function", "if",
+		"import", "in", "instanceof", "let", "new", "null", "of", "return",
+		"static", "super", "switch", "this", "throw", "true", "try", "typeof",
+		"undefined", "var", "void", "while", "with", "yield",
+	}
+
+	jsLexer = &lexer{
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       append([]stringSyntax{{open: "`", close: "`", multiline: true}}, cStrings...),
+		keywords:      words(jsKeywords...),
+		identRunes:    "$",
+	}
+
+	tsLexer = &lexer{
+		lineComments:  jsLexer.lineComments,
+		blockComments: jsLexer.blockComments,
+		strings:       jsLexer.strings,
+		keywords: words(append(jsKeywords,
+			"abstract", "any", "as", "boolean", "declare", "enum", "implements",
+			"interface", "keyof", "namespace", "never", "number", "private",
+			"protected", "public", "readonly", "string", "type", "unknown")...),
+		identRunes: "$",
+	}
+
+	shellLexer = &lexer{
+		lineComments: []string{"#"},
+		strings: []stringSyntax{
+			{open: `"`, close: `"`, multiline: true},
+			{open: `'`, close: `'`, raw: true, multiline: true},
+		},
+		keywords: words(
+			"case", "do", "done", "elif", "else", "esac", "export", "fi", "for",
+			"function", "if", "in", "local", "readonly", "return", "select",
+			"then", "until", "while"),
+	}
+)
+
+// lexers are keyed by the language names of go-enry, as found in
+// Document.Language.
+var lexers = map[string]*lexer{
+	"Go": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings: []stringSyntax{
+			{open: "`", close: "`", raw: true, multiline: true},
+			{open: `"`, close: `"`},
+			{open: `'`, close: `'`},
+		},
+		keywords: words(
+			"break", "case", "chan", "const", "continue", "default", "defer",
+			"else", "fallthrough", "for", "func", "go", "goto", "if", "import",
+			"interface", "map", "package", "range", "return", "select",
+			"struct", "switch", "type", "var", "nil", "true", "false", "iota"),
+	},
+	"Python": {
+		lineComments: []string{"#"},
+		strings: []stringSyntax{
+			{open: `"""`, close: `"""`, multiline: true},
+			{open: `'''`, close: `'''`, multiline: true},
+			{open: `"`, close: `"`},
+			{open: `'`, close: `'`},
+		},
+		keywords: words(
+			"False", "None", "True", "and", "as", "assert", "async", "await",
+			"break", "class", "continue", "def", "del", "elif", "else",
+			"except", "finally", "for", "from", "global", "if", "import", "in",
+			"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return",
+			"try", "while", "with", "yield"),
+	},
+	"C": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       cStrings,
+		keywords:      words(cKeywords...),
+	},
+	"C++": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       cStrings,
+		keywords: words(append(cKeywords,
+			"bool", "catch", "class", "constexpr", "delete", "explicit",
+			"friend", "mutable", "namespace", "new", "noexcept", "nullptr",
+			"operator", "override", "private", "protected", "public",
+			"template", "this", "throw", "try", "typename", "using", "virtual")...),
+	},
+	"C#": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       cStrings,
+		keywords: words(
+			"abstract", "as", "async", "await", "base", "bool", "break", "case",
+			"catch", "class", "const", "continue", "default", "do", "else",
+			"enum", "false", "finally", "for", "foreach", "if", "in", "int",
+			"interface", "internal", "is", "namespace", "new", "null",
+			"override", "private", "protected", "public", "readonly", "return",
+			"sealed", "static", "string", "struct", "switch", "this", "throw",
+			"true", "try", "using", "var", "virtual", "void", "while"),
+	},
+	"Java": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       append([]stringSyntax{{open: `"""`, close: `"""`, multiline: true}}, cStrings...),
+		keywords: words(
+			"abstract", "boolean", "break", "byte", "case", "catch", "char",
+			"class", "continue", "default", "do", "double", "else", "enum",
+			"extends", "false", "final", "finally", "float", "for", "if",
+			"implements", "import", "instanceof", "int", "interface", "long",
+			"new", "null", "package", "private", "protected", "public",
+			"return", "short", "static", "super", "switch", "synchronized",
+			"this", "throw", "throws", "true", "try", "var", "void", "while"),
+	},
+	"Kotlin": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       append([]stringSyntax{{open: `"""`, close: `"""`, raw: true, multiline: true}}, cStrings...),
+		keywords: words(
+			"as", "break", "class", "companion", "continue", "data", "do",
+			"else", "false", "for", "fun", "if", "import", "in", "interface",
+			"is", "null", "object", "override", "package", "private",
+			"return", "sealed", "super", "this", "throw", "true", "try", "val",
+			"var", "when", "while"),
+	},
+	"Rust": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings:       []stringSyntax{{open: `"`, close: `"`, multiline: true}},
+		keywords: words(
+			"as", "async", "await", "break", "const", "continue", "crate",
+			"dyn", "else", "enum", "false", "fn", "for", "if", "impl", "in",
+			"let", "loop", "match", "mod", "move", "mut", "pub", "ref",
+			"return", "self", "Self", "static", "struct", "super", "trait",
+			"true", "type", "unsafe", "use", "where", "while"),
+	},
+	"Ruby": {
+		lineComments: []string{"#"},
+		strings: []stringSyntax{
+			{open: `"`, close: `"`, multiline: true},
+			{open: `'`, close: `'`, multiline: true},
+		},
+		keywords: words(
+			"alias", "and", "begin", "break", "case", "class", "def", "do",
+			"else", "elsif", "end", "ensure", "false", "for", "if", "in",
+			"module", "next", "nil", "not", "or", "redo", "rescue", "retry",
+			"return", "self", "super", "then", "true", "unless", "until",
+			"when", "while", "yield"),
+		identRunes: "?!",
+	},
+	"PHP": {
+		lineComments:  []string{"//", "#"},
+		blockComments: cComment,
+		strings: []stringSyntax{
+			{open: `"`, close: `"`, multiline: true},
+			{open: `'`, close: `'`, multiline: true},
+		},
+		keywords: words(
+			"abstract", "array", "as", "break", "case", "catch", "class",
+			"const", "continue", "default", "do", "echo", "else", "elseif",
+			"extends", "false", "final", "finally", "fn", "for", "foreach",
+			"function", "if", "implements", "interface", "namespace", "new",
+			"null", "private", "protected", "public", "return", "static",
+			"switch", "throw", "true", "try", "use", "while"),
+		identRunes: "$",
+	},
+	"Swift": {
+		lineComments:  []string{"//"},
+		blockComments: cComment,
+		strings: []stringSyntax{
+			{open: `"""`, close: `"""`, multiline: true},
+			{open: `"`, close: `"`},
+		},
+		keywords: words(
+			"as", "break", "case", "catch", "class", "continue", "default",
+			"defer", "do", "else", "enum", "extension", "false", "for", "func",
+			"guard", "if", "import", "in", "init", "let", "nil", "private",
+			"protocol", "public", "return", "self", "static", "struct",
+			"switch", "throw", "throws", "true", "try", "var", "where", "while"),
+	},
+	"JavaScript": jsLexer,
+	"JSX":        jsLexer,
+	"TypeScript": tsLexer,
+	"TSX":        tsLexer,
+	"Shell":      shellLexer,
+}
+
+// Tokenize returns the keywords, string literals, comments and identifiers
+// of content, a document in language, in order. It returns nil for
+// languages without a lexer.
+func Tokenize(language string, content []byte) []zoekt.SyntaxToken {
+	l, ok := lexers[language]
+	if !ok {
+		return nil
+	}
+	return l.tokenize(content)
+}
+
+func (l *lexer) isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) ||
+		(r < utf8.RuneSelf && bytes.IndexByte([]byte(l.identRunes), byte(r)) >= 0)
+}
+
+func (l *lexer) tokenize(content []byte) []zoekt.SyntaxToken {
+	var toks []zoekt.SyntaxToken
+	add := func(start, end int, class zoekt.TokenClass) {
+		toks = append(toks, zoekt.SyntaxToken{Start: uint32(start), End: uint32(end), Class: class})
+	}
+
+	i := 0
+next:
+	for i < len(content) {
+		rest := content[i:]
+
+		for _, prefix := range l.lineComments {
+			if bytes.HasPrefix(rest, []byte(prefix)) {
+				end := bytes.IndexByte(rest, '\n')
+				if end < 0 {
+					end = len(rest)
+				}
+				add(i, i+end, zoekt.TokenComment)
+				i += end
+				continue next
+			}
+		}
+
+		for _, delims := range l.blockComments {
+			if bytes.HasPrefix(rest, []byte(delims[0])) {
+				end := bytes.Index(rest[len(delims[0]):], []byte(delims[1]))
+				if end < 0 {
+					end = len(rest)
+				} else {
+					end += len(delims[0]) + len(delims[1])
+				}
+				add(i, i+end, zoekt.TokenComment)
+				i += end
+				continue next
+			}
+		}
+
+		for _, s := range l.strings {
+			if bytes.HasPrefix(rest, []byte(s.open)) {
+				end := s.end(rest)
+				add(i, i+end, zoekt.TokenString)
+				i += end
+				continue next
+			}
+		}
+
+		r, sz := utf8.DecodeRune(rest)
+		if unicode.IsDigit(r) {
+			// Skip numbers, including suffixes like 0x1f or 10u, so that
+			// they aren't mistaken for identifiers.
+			for sz < len(rest) {
+				r, n := utf8.DecodeRune(rest[sz:])
+				if !l.isIdentRune(r) {
+					break
+				}
+				sz += n
+			}
+		} else if l.isIdentRune(r) {
+			for sz < len(rest) {
+				r, n := utf8.DecodeRune(rest[sz:])
+				if !l.isIdentRune(r) {
+					break
+				}
+				sz += n
+			}
+			class := zoekt.TokenIdentifier
+			if l.keywords[string(rest[:sz])] {
+				class = zoekt.TokenKeyword
+			}
+			add(i, i+sz, class)
+		}
+		i += sz
+	}
+	return toks
+}
+
+// end returns the length of the string literal at the start of b.
+func (s *stringSyntax) end(b []byte) int {
+	i := len(s.open)
+	for i < len(b) {
+		switch {
+		case bytes.HasPrefix(b[i:], []byte(s.close)):
+			return i + len(s.close)
+		case b[i] == '\\' && !s.raw:
+			i += 2
+		case b[i] == '\n' && !s.multiline:
+			return i
+		default:
+			i++
+		}
+	}
+	return len(b)
+}
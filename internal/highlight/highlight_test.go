@@ -0,0 +1,60 @@
+package highlight
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
+)
+
+func TestTokenize(t *testing.T) {
+	for _, tc := range []struct {
+		language string
+		content  string
+		want     []string
+	}{{
+		language: "Go",
+		content:  "func f() string { return `a\n\"b` + \"c\\\"d\" } // done",
+		want: []string{
+			"keyword func", "identifier f", "identifier string", "keyword return",
+			"string `a\n\"b`", `string "c\"d"`, "comment // done",
+		},
+	}, {
+		language: "Python",
+		content:  "def f(x):\n    '''doc\n    string'''\n    return x + 0x1f  # hex\n",
+		want: []string{
+			"keyword def", "identifier f", "identifier x",
+			"string '''doc\n    string'''", "keyword return", "identifier x", "comment # hex",
+		},
+	}, {
+		language: "JavaScript",
+		content:  "const $el = /* a */ 'x\ny';",
+		want: []string{
+			"keyword const", "identifier $el", "comment /* a */", "string 'x", "identifier y", "string ';",
+		},
+	}, {
+		language: "C",
+		content:  "int x; /* unterminated",
+		want:     []string{"keyword int", "identifier x", "comment /* unterminated"},
+	}, {
+		language: "Markdown",
+		content:  "# func",
+	}} {
+		var got []string
+		for _, tok := range Tokenize(tc.language, []byte(tc.content)) {
+			got = append(got, tok.Class.String()+" "+tc.content[tok.Start:tok.End])
+		}
+		if d := cmp.Diff(tc.want, got); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.language, d)
+		}
+	}
+}
+
+func TestTokenizeOffsets(t *testing.T) {
+	got := Tokenize("Go", []byte("été := 1"))
+	want := []zoekt.SyntaxToken{{Start: 0, End: 5, Class: zoekt.TokenIdentifier}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
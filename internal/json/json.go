@@ -21,6 +21,7 @@ func JSONServer(searcher zoekt.Searcher) http.Handler {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/search", s.jsonSearch)
 	mux.HandleFunc("/list", s.jsonList)
+	mux.HandleFunc("/explain", s.jsonExplain)
 	return mux
 }
 
@@ -38,6 +39,10 @@ type jsonSearchReply struct {
 	Result *zoekt.SearchResult
 }
 
+type jsonExplainReply struct {
+	Explanation *zoekt.Explanation
+}
+
 type jsonListArgs struct {
 	Q    string
 	Opts *zoekt.ListOptions
@@ -47,38 +52,47 @@ type jsonListReply struct {
 	List *zoekt.RepoList
 }
 
-func (s *jsonSearcher) jsonSearch(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	w.Header().Add("Content-Type", "application/json")
-
+// readSearchArgs decodes the jsonSearchArgs of a request. If it fails, it
+// writes the error to w and returns ok=false.
+func readSearchArgs(w http.ResponseWriter, req *http.Request) (q query.Q, searchArgs jsonSearchArgs, ok bool) {
 	if req.Method != "POST" {
 		jsonError(w, http.StatusMethodNotAllowed, "Only POST is supported")
-		return
+		return nil, searchArgs, false
 	}
 
-	searchArgs := jsonSearchArgs{}
 	err := json.NewDecoder(req.Body).Decode(&searchArgs)
 	if err != nil {
 		jsonError(w, http.StatusBadRequest, err.Error())
-		return
+		return nil, searchArgs, false
 	}
 	if searchArgs.Q == "" {
 		jsonError(w, http.StatusBadRequest, "missing query")
-		return
+		return nil, searchArgs, false
 	}
 	if searchArgs.Opts == nil {
 		searchArgs.Opts = &zoekt.SearchOptions{}
 	}
 
-	q, err := query.Parse(searchArgs.Q)
+	q, err = query.Parse(searchArgs.Q)
 	if err != nil {
 		jsonError(w, http.StatusBadRequest, err.Error())
-		return
+		return nil, searchArgs, false
 	}
 
 	if searchArgs.RepoIDs != nil {
 		q = query.NewAnd(q, query.NewRepoIDs(*searchArgs.RepoIDs...))
 	}
+	return q, searchArgs, true
+}
+
+func (s *jsonSearcher) jsonSearch(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	w.Header().Add("Content-Type", "application/json")
+
+	q, searchArgs, ok := readSearchArgs(w, req)
+	if !ok {
+		return
+	}
 
 	// Set a timeout if the user hasn't specified one.
 	if searchArgs.Opts.MaxWallTime == 0 {
@@ -117,6 +131,35 @@ func (s *jsonSearcher) jsonSearch(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (s *jsonSearcher) jsonExplain(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	w.Header().Add("Content-Type", "application/json")
+
+	q, searchArgs, ok := readSearchArgs(w, req)
+	if !ok {
+		return
+	}
+
+	// Set a timeout if the user hasn't specified one.
+	if searchArgs.Opts.MaxWallTime == 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+
+	explanation, err := s.Searcher.Explain(ctx, q, searchArgs.Opts)
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(jsonExplainReply{explanation})
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+}
+
 func jsonError(w http.ResponseWriter, statusCode int, err string) {
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(struct{ Error string }{Error: err})
@@ -90,6 +90,54 @@ func TestClientServer(t *testing.T) {
 	}
 }
 
+func TestExplain(t *testing.T) {
+	searchQuery := "hello"
+	mock := &mockSearcher.MockSearcher{
+		WantSearch: mustParse(searchQuery),
+		Explanation: &zoekt.Explanation{
+			Query: "substr:\"hello\"",
+			Shards: []zoekt.ShardExplanation{{
+				Shard:     "repo_v16.00000.zoekt",
+				MatchTree: `substr("hello")`,
+				Ngrams: []zoekt.NgramExplanation{{
+					Pattern:          "hello",
+					Ngrams:           []string{"hel", "llo"},
+					PostingListSizes: []uint32{2, 3},
+				}},
+			}},
+		},
+	}
+
+	ts := httptest.NewServer(zjson.JSONServer(mock))
+	defer ts.Close()
+
+	r, err := http.Post(ts.URL+"/explain", "application/json", bytes.NewBufferString(`{"Q":"hello"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != 200 {
+		body, _ := io.ReadAll(r.Body)
+		t.Fatalf("Got status code %d, err %s", r.StatusCode, string(body))
+	}
+
+	var explainResult struct{ Explanation *zoekt.Explanation }
+	err = json.NewDecoder(r.Body).Decode(&explainResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(explainResult.Explanation, mock.Explanation) {
+		t.Fatalf("got %+v, want %+v", explainResult.Explanation, mock.Explanation)
+	}
+
+	r, err = http.Post(ts.URL+"/explain", "application/json", bytes.NewBufferString(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusBadRequest {
+		t.Fatalf("got status code %d for missing query, want %d", r.StatusCode, http.StatusBadRequest)
+	}
+}
+
 func TestClientServerWithRepoIDsProvided(t *testing.T) {
 	searchQuery := "hello"
 	expectedSearch := mustParse(searchQuery)
@@ -14,6 +14,10 @@ type MockSearcher struct {
 
 	WantList query.Q
 	RepoList *zoekt.RepoList
+
+	Explanation *zoekt.Explanation
+
+	CloneResult *zoekt.CloneResult
 }
 
 func (s *MockSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
@@ -30,6 +34,24 @@ func (s *MockSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOpti
 	return s.RepoList, nil
 }
 
+// References searches for &query.Occurrence{Symbol: symbol}.
+func (s *MockSearcher) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	return s.Search(ctx, &query.Occurrence{Symbol: symbol}, opts)
+}
+
+// Explain returns Explanation for WantSearch.
+func (s *MockSearcher) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.Explanation, error) {
+	if q.String() != s.WantSearch.String() {
+		return nil, fmt.Errorf("got query %s != %s", q.String(), s.WantSearch.String())
+	}
+	return s.Explanation, nil
+}
+
+// Clones returns CloneResult.
+func (s *MockSearcher) Clones(ctx context.Context, content []byte, opts *zoekt.CloneOptions) (*zoekt.CloneResult, error) {
+	return s.CloneResult, nil
+}
+
 func (*MockSearcher) Close() {}
 
 func (*MockSearcher) String() string {
@@ -0,0 +1,352 @@
+// Package scip decodes SCIP indexes, the code intelligence format produced
+// by indexers like scip-go, scip-java and scip-typescript. See
+// https://github.com/sourcegraph/scip for the format.
+//
+// Only the parts of an index that zoekt stores are decoded: documents with
+// their occurrences, and symbol information with hover documentation.
+package scip
+
+import (
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Index is a decoded SCIP index.
+type Index struct {
+	// ProjectRoot is the URI of the directory the document paths are
+	// relative to.
+	ProjectRoot string
+
+	Documents []*Document
+
+	// ExternalSymbols holds information about symbols that are defined
+	// outside of the indexed documents.
+	ExternalSymbols []*SymbolInformation
+}
+
+// Document holds the occurrences of symbols within a single file.
+type Document struct {
+	// RelativePath is the path of the file relative to the project root,
+	// using forward slashes.
+	RelativePath string
+
+	Language string
+
+	// PositionEncoding specifies how the character offsets of the ranges
+	// of occurrences are counted.
+	PositionEncoding PositionEncoding
+
+	Occurrences []*Occurrence
+
+	// Symbols holds information about the symbols defined in the document.
+	Symbols []*SymbolInformation
+}
+
+// PositionEncoding is the unit of the character offsets in Occurrence.Range.
+type PositionEncoding int32
+
+const (
+	PositionEncodingUnspecified PositionEncoding = 0
+	PositionEncodingUTF8        PositionEncoding = 1
+	PositionEncodingUTF16       PositionEncoding = 2
+	PositionEncodingUTF32       PositionEncoding = 3
+)
+
+// Occurrence is a reference to or definition of a symbol in a document.
+type Occurrence struct {
+	// Range is [startLine, startCharacter, endLine, endCharacter], or
+	// [startLine, startCharacter, endCharacter] if the occurrence doesn't
+	// span lines. Lines and characters are 0-based.
+	Range []int32
+
+	Symbol string
+
+	// SymbolRoles is a bitset of SymbolRole values.
+	SymbolRoles int32
+}
+
+// SymbolRoleDefinition is set in Occurrence.SymbolRoles for definitions.
+const SymbolRoleDefinition int32 = 0x1
+
+// IsDefinition returns true if the occurrence defines its symbol.
+func (o *Occurrence) IsDefinition() bool {
+	return o.SymbolRoles&SymbolRoleDefinition != 0
+}
+
+// SymbolInformation holds information about a symbol.
+type SymbolInformation struct {
+	Symbol string
+
+	// Documentation is the hover documentation of the symbol, as markdown.
+	Documentation []string
+
+	// DisplayName is the name of the symbol as shown to users. It is
+	// optional, see Name for a fallback.
+	DisplayName string
+}
+
+// Field numbers of the messages in scip.proto.
+const (
+	fieldIndexMetadata        = 1
+	fieldIndexDocuments       = 2
+	fieldIndexExternalSymbols = 3
+
+	fieldMetadataProjectRoot = 3
+
+	fieldDocumentRelativePath     = 1
+	fieldDocumentOccurrences      = 2
+	fieldDocumentSymbols          = 3
+	fieldDocumentLanguage         = 4
+	fieldDocumentPositionEncoding = 6
+
+	fieldOccurrenceRange       = 1
+	fieldOccurrenceSymbol      = 2
+	fieldOccurrenceSymbolRoles = 3
+
+	fieldSymbolSymbol        = 1
+	fieldSymbolDocumentation = 3
+	fieldSymbolDisplayName   = 6
+)
+
+// ReadFile reads and decodes the SCIP index at path.
+func ReadFile(path string) (*Index, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("scip: %s: %w", path, err)
+	}
+	return idx, nil
+}
+
+// Unmarshal decodes a SCIP index from its protobuf encoding.
+func Unmarshal(data []byte) (*Index, error) {
+	idx := &Index{}
+	err := decodeFields(data, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		switch num {
+		case fieldIndexMetadata:
+			return decodeFields(v, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+				if num == fieldMetadataProjectRoot && typ == protowire.BytesType {
+					idx.ProjectRoot = string(v)
+				}
+				return nil
+			})
+		case fieldIndexDocuments:
+			doc, err := decodeDocument(v)
+			if err != nil {
+				return err
+			}
+			idx.Documents = append(idx.Documents, doc)
+		case fieldIndexExternalSymbols:
+			sym, err := decodeSymbolInformation(v)
+			if err != nil {
+				return err
+			}
+			idx.ExternalSymbols = append(idx.ExternalSymbols, sym)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func decodeDocument(data []byte) (*Document, error) {
+	doc := &Document{}
+	err := decodeFields(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case fieldDocumentRelativePath:
+			doc.RelativePath = string(v)
+		case fieldDocumentLanguage:
+			doc.Language = string(v)
+		case fieldDocumentPositionEncoding:
+			doc.PositionEncoding = PositionEncoding(n)
+		case fieldDocumentOccurrences:
+			occ, err := decodeOccurrence(v)
+			if err != nil {
+				return err
+			}
+			doc.Occurrences = append(doc.Occurrences, occ)
+		case fieldDocumentSymbols:
+			sym, err := decodeSymbolInformation(v)
+			if err != nil {
+				return err
+			}
+			doc.Symbols = append(doc.Symbols, sym)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func decodeOccurrence(data []byte) (*Occurrence, error) {
+	occ := &Occurrence{}
+	err := decodeFields(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case fieldOccurrenceRange:
+			if typ == protowire.VarintType {
+				occ.Range = append(occ.Range, int32(n))
+				return nil
+			}
+			// Packed encoding.
+			for len(v) > 0 {
+				x, sz := protowire.ConsumeVarint(v)
+				if sz < 0 {
+					return protowire.ParseError(sz)
+				}
+				occ.Range = append(occ.Range, int32(x))
+				v = v[sz:]
+			}
+		case fieldOccurrenceSymbol:
+			occ.Symbol = string(v)
+		case fieldOccurrenceSymbolRoles:
+			occ.SymbolRoles = int32(n)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return occ, nil
+}
+
+func decodeSymbolInformation(data []byte) (*SymbolInformation, error) {
+	sym := &SymbolInformation{}
+	err := decodeFields(data, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		switch num {
+		case fieldSymbolSymbol:
+			sym.Symbol = string(v)
+		case fieldSymbolDocumentation:
+			sym.Documentation = append(sym.Documentation, string(v))
+		case fieldSymbolDisplayName:
+			sym.DisplayName = string(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sym, nil
+}
+
+// decodeFields calls f for each field of the protobuf message in data. For
+// length-delimited fields v holds the value, for varint fields n does.
+// Fields of other types are skipped.
+func decodeFields(data []byte, f func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error) error {
+	for len(data) > 0 {
+		num, typ, sz := protowire.ConsumeTag(data)
+		if sz < 0 {
+			return protowire.ParseError(sz)
+		}
+		data = data[sz:]
+
+		var (
+			v []byte
+			n uint64
+		)
+		switch typ {
+		case protowire.BytesType:
+			v, sz = protowire.ConsumeBytes(data)
+		case protowire.VarintType:
+			n, sz = protowire.ConsumeVarint(data)
+		default:
+			sz = protowire.ConsumeFieldValue(num, typ, data)
+			if sz >= 0 {
+				data = data[sz:]
+				continue
+			}
+		}
+		if sz < 0 {
+			return protowire.ParseError(sz)
+		}
+		data = data[sz:]
+
+		if err := f(num, typ, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Marshal returns the protobuf encoding of the parts of idx that Unmarshal
+// decodes.
+func (idx *Index) Marshal() []byte {
+	var b []byte
+	if idx.ProjectRoot != "" {
+		var m []byte
+		m = appendString(m, fieldMetadataProjectRoot, idx.ProjectRoot)
+		b = appendMessage(b, fieldIndexMetadata, m)
+	}
+	for _, doc := range idx.Documents {
+		b = appendMessage(b, fieldIndexDocuments, doc.marshal())
+	}
+	for _, sym := range idx.ExternalSymbols {
+		b = appendMessage(b, fieldIndexExternalSymbols, sym.marshal())
+	}
+	return b
+}
+
+func (doc *Document) marshal() []byte {
+	var b []byte
+	b = appendString(b, fieldDocumentRelativePath, doc.RelativePath)
+	for _, occ := range doc.Occurrences {
+		b = appendMessage(b, fieldDocumentOccurrences, occ.marshal())
+	}
+	for _, sym := range doc.Symbols {
+		b = appendMessage(b, fieldDocumentSymbols, sym.marshal())
+	}
+	b = appendString(b, fieldDocumentLanguage, doc.Language)
+	b = appendVarint(b, fieldDocumentPositionEncoding, uint64(doc.PositionEncoding))
+	return b
+}
+
+func (occ *Occurrence) marshal() []byte {
+	var b, packed []byte
+	for _, x := range occ.Range {
+		packed = protowire.AppendVarint(packed, uint64(x))
+	}
+	b = appendMessage(b, fieldOccurrenceRange, packed)
+	b = appendString(b, fieldOccurrenceSymbol, occ.Symbol)
+	b = appendVarint(b, fieldOccurrenceSymbolRoles, uint64(occ.SymbolRoles))
+	return b
+}
+
+func (sym *SymbolInformation) marshal() []byte {
+	var b []byte
+	b = appendString(b, fieldSymbolSymbol, sym.Symbol)
+	for _, d := range sym.Documentation {
+		b = appendMessage(b, fieldSymbolDocumentation, []byte(d))
+	}
+	b = appendString(b, fieldSymbolDisplayName, sym.DisplayName)
+	return b
+}
+
+func appendMessage(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// appendString appends a string field, omitting it if it's empty like
+// proto3 does.
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	return appendMessage(b, num, []byte(s))
+}
+
+func appendVarint(b []byte, num protowire.Number, n uint64) []byte {
+	if n == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, n)
+}
@@ -0,0 +1,95 @@
+package scip
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestMarshalRoundtrip(t *testing.T) {
+	want := &Index{
+		ProjectRoot: "file:///src/example",
+		Documents: []*Document{{
+			RelativePath:     "pkg/foo.go",
+			Language:         "go",
+			PositionEncoding: PositionEncodingUTF8,
+			Occurrences: []*Occurrence{
+				{Range: []int32{2, 5, 8}, Symbol: "scip-go gomod example v1 `example/pkg`/Foo().", SymbolRoles: SymbolRoleDefinition},
+				{Range: []int32{4, 1, 5, 2}, Symbol: "local 0"},
+			},
+			Symbols: []*SymbolInformation{{
+				Symbol:        "scip-go gomod example v1 `example/pkg`/Foo().",
+				Documentation: []string{"```go\nfunc Foo()\n```", "Foo does things."},
+			}},
+		}},
+		ExternalSymbols: []*SymbolInformation{{
+			Symbol:      "scip-go gomod std . fmt/Println().",
+			DisplayName: "Println",
+		}},
+	}
+
+	got, err := Unmarshal(want.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestUnmarshalSkipsUnknownFields(t *testing.T) {
+	var occ []byte
+	// An unpacked range and an unknown fixed32 field.
+	for _, x := range []uint64{1, 2, 3} {
+		occ = protowire.AppendTag(occ, fieldOccurrenceRange, protowire.VarintType)
+		occ = protowire.AppendVarint(occ, x)
+	}
+	occ = protowire.AppendTag(occ, 99, protowire.Fixed32Type)
+	occ = protowire.AppendFixed32(occ, 42)
+	occ = appendString(occ, fieldOccurrenceSymbol, "local 1")
+
+	doc := appendString(nil, fieldDocumentRelativePath, "a.go")
+	doc = appendMessage(doc, fieldDocumentOccurrences, occ)
+	data := appendMessage(nil, fieldIndexDocuments, doc)
+
+	got, err := Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Index{Documents: []*Document{{
+		RelativePath: "a.go",
+		Occurrences:  []*Occurrence{{Range: []int32{1, 2, 3}, Symbol: "local 1"}},
+	}}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	if _, err := Unmarshal(data[:len(data)-1]); err == nil {
+		t.Error("got no error for truncated index")
+	}
+}
+
+func TestName(t *testing.T) {
+	for _, tc := range []struct {
+		symbol string
+		want   string
+	}{
+		{"scip-go gomod example v1 `example/pkg`/Foo().", "Foo"},
+		{"scip-go gomod example v1 `example/pkg`/Bar#Baz().", "Baz"},
+		{"scip-go gomod example v1 `example/pkg`/Bar#", "Bar"},
+		{"scip-go gomod example v1 `example/pkg`/Bar#field.", "field"},
+		{"scip-java maven . . com/example/Foo#bar(+1).", "bar"},
+		{"scip-typescript npm pkg 1.0 src/`index.ts`/foo().(x)", "x"},
+		{"scip-typescript npm pkg 1.0 src/`index.ts`/Box#[T]", "T"},
+		{"scip-python python my  pkg 1.0 mod/`we``ird`.", "we`ird"},
+		{"rust-analyzer cargo std . macros/println!", "println"},
+		{"local 12", ""},
+		{"scip-go gomod", ""},
+		{"scip-go gomod example v1 `unterminated", ""},
+	} {
+		if got := Name(tc.symbol); got != tc.want {
+			t.Errorf("Name(%q) = %q, want %q", tc.symbol, got, tc.want)
+		}
+	}
+}
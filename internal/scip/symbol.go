@@ -0,0 +1,118 @@
+package scip
+
+import "strings"
+
+// IsLocal returns true for symbols that are local to a document, like
+// "local 12". Their names are only unique within the document.
+func IsLocal(symbol string) bool {
+	return strings.HasPrefix(symbol, "local ")
+}
+
+// Name returns the name of the last descriptor of a global symbol, e.g.
+// "Foo" for "scip-go gomod example v1 `example/pkg`/Bar#Foo().". It returns
+// "" for local and malformed symbols.
+func Name(symbol string) string {
+	if IsLocal(symbol) {
+		return ""
+	}
+
+	// Skip the scheme and the package manager, name and version, which are
+	// separated by spaces. Spaces within them are escaped as double spaces.
+	rest := symbol
+	for range 4 {
+		i := 0
+		for {
+			j := strings.IndexByte(rest[i:], ' ')
+			if j < 0 {
+				return ""
+			}
+			i += j
+			if i+1 < len(rest) && rest[i+1] == ' ' {
+				i += 2
+				continue
+			}
+			break
+		}
+		rest = rest[i+1:]
+	}
+
+	return lastDescriptorName(rest)
+}
+
+// lastDescriptorName returns the name of the last of the descriptors in s.
+func lastDescriptorName(s string) string {
+	var name string
+	for len(s) > 0 {
+		var (
+			n    string
+			rest string
+			ok   bool
+		)
+		switch s[0] {
+		case '(', '[':
+			// Parameter "(name)" or type parameter "[name]".
+			closing := byte(')')
+			if s[0] == '[' {
+				closing = ']'
+			}
+			n, rest, ok = parseDescriptorName(s[1:])
+			if !ok || rest == "" || rest[0] != closing {
+				return ""
+			}
+			rest = rest[1:]
+		default:
+			n, rest, ok = parseDescriptorName(s)
+			if !ok || rest == "" {
+				return ""
+			}
+			switch rest[0] {
+			case '/', '#', '.', ':', '!':
+				// Namespace, type, term, meta and macro.
+				rest = rest[1:]
+			case '(':
+				// Method "name(disambiguator)."
+				end := strings.IndexByte(rest, ')')
+				if end < 0 || end+1 >= len(rest) || rest[end+1] != '.' {
+					return ""
+				}
+				rest = rest[end+2:]
+			default:
+				return ""
+			}
+		}
+		name, s = n, rest
+	}
+	return name
+}
+
+// parseDescriptorName parses a simple or backtick-escaped name at the start
+// of s, and returns it along with the rest of s.
+func parseDescriptorName(s string) (name, rest string, ok bool) {
+	if s != "" && s[0] == '`' {
+		var b strings.Builder
+		for i := 1; i < len(s); i++ {
+			if s[i] != '`' {
+				b.WriteByte(s[i])
+				continue
+			}
+			if i+1 < len(s) && s[i+1] == '`' {
+				b.WriteByte('`')
+				i++
+				continue
+			}
+			return b.String(), s[i+1:], true
+		}
+		return "", "", false
+	}
+
+	i := 0
+	for i < len(s) && isIdentifierChar(s[i]) {
+		i++
+	}
+	return s[:i], s[i:], i > 0
+}
+
+func isIdentifierChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '_' || c == '+' || c == '-' || c == '$'
+}
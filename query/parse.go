@@ -19,6 +19,10 @@ import (
 	"fmt"
 	"log"
 	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/grafana/regexp"
 
@@ -68,6 +72,70 @@ func (o *orOperator) String() string {
 	return "orOp"
 }
 
+// nearOperator is a placeholder intermediate so we can represent [A,
+// NEAR/n, B] before we convert it to Near{A, B}.
+type nearOperator struct {
+	distance int
+}
+
+func (o *nearOperator) String() string {
+	return fmt.Sprintf("nearOp/%d", o.distance)
+}
+
+// parseNearOperator parses the distance of the NEAR/n operator.
+func parseNearOperator(text []byte) (int, bool) {
+	n, ok := bytes.CutPrefix(text, []byte("NEAR/"))
+	if !ok {
+		return 0, false
+	}
+	distance, err := strconv.Atoi(string(n))
+	if err != nil || distance < 0 {
+		return 0, false
+	}
+	return distance, true
+}
+
+// parseNearOperators replaces [A, NEAR/n, B] in qs by Near{A, B}. NEAR binds
+// tighter than AND and OR, and chains of NEAR with the same distance form a
+// single Near.
+func parseNearOperators(qs []Q) ([]Q, error) {
+	var out []Q
+	for i := 0; i < len(qs); i++ {
+		op, ok := qs[i].(*nearOperator)
+		if !ok {
+			out = append(out, qs[i])
+			continue
+		}
+
+		if len(out) == 0 || i+1 == len(qs) || !isNearOperand(out[len(out)-1]) || !isNearOperand(qs[i+1]) {
+			return nil, fmt.Errorf("query: NEAR operator should have operands")
+		}
+		left, right := out[len(out)-1], qs[i+1]
+		i++
+		if _, ok := left.(*Not); ok {
+			return nil, fmt.Errorf("query: NEAR operands can't be negated")
+		}
+		if _, ok := right.(*Not); ok {
+			return nil, fmt.Errorf("query: NEAR operands can't be negated")
+		}
+
+		if n, ok := left.(*Near); ok && n.Distance == op.distance {
+			n.Children = append(n.Children, right)
+			continue
+		}
+		out[len(out)-1] = &Near{Children: []Q{left, right}, Distance: op.distance}
+	}
+	return out, nil
+}
+
+func isNearOperand(q Q) bool {
+	switch q.(type) {
+	case *orOperator, *nearOperator, *caseQ, *Type:
+		return false
+	}
+	return true
+}
+
 func isSpace(c byte) bool {
 	return c == ' ' || c == '\t'
 }
@@ -239,11 +307,95 @@ func parseExpr(in []byte) (Q, int, error) {
 			t = TypeFileName
 		case "repo":
 			t = TypeRepo
+		case "commit":
+			t = TypeCommit
 		default:
-			return nil, 0, fmt.Errorf("query: unknown type argument %q, want {filematch,filename,repo}", text)
+			return nil, 0, fmt.Errorf("query: unknown type argument %q, want {filematch,filename,repo,commit}", text)
 		}
 		// Later we will lift this into a root, like we do for caseQ
 		expr = &Type{Type: t, Child: nil}
+	case tokAuthor:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the author: atom must have an argument")
+		}
+		// Like case:auto, match case insensitively unless the pattern
+		// contains upper case characters.
+		if text == string(toLower([]byte(text))) {
+			text = "(?i)" + text
+		}
+		r, err := regexp.Compile(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		expr = &Author{Regexp: r}
+	case tokBefore, tokAfter:
+		t, err := parseDate(text, time.Now())
+		if err != nil {
+			return nil, 0, err
+		}
+		if tok.Type == tokBefore {
+			expr = &CommitDate{Before: t}
+		} else {
+			expr = &CommitDate{After: t}
+		}
+	case tokMessage:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the message: atom must have an argument")
+		}
+		r, err := syntax.Parse(text, regexpFlags)
+		if err != nil {
+			return nil, 0, err
+		}
+		expr = &Message{Regexp: OptimizeRegexp(r, regexpFlags)}
+	case tokDef, tokRef:
+		if text == "" {
+			atom := "ref:"
+			if tok.Type == tokDef {
+				atom = "def:"
+			}
+			return nil, 0, fmt.Errorf("the %s atom must have an argument", atom)
+		}
+		// Like case:auto, match case insensitively unless the pattern
+		// contains upper case characters.
+		if text == string(toLower([]byte(text))) {
+			text = "(?i)" + text
+		}
+		r, err := regexp.Compile(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		expr = &Occurrence{Regexp: r, Definition: tok.Type == tokDef}
+	case tokRefs:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the refs: atom must have an argument")
+		}
+		expr = &SymbolRefs{Name: text}
+	case tokStruct:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the struct: atom must have an argument")
+		}
+		expr = &Structural{Pattern: text}
+	case tokFuzzyFile:
+		if text == "" {
+			return nil, 0, fmt.Errorf("the file~: atom must have an argument")
+		}
+		expr = &FuzzyFileName{Pattern: text}
+	case tokCategory:
+		if !slices.Contains(FileCategories, text) {
+			return nil, 0, fmt.Errorf("query: unknown category argument %q, want {%s}", text, strings.Join(FileCategories, ","))
+		}
+		expr = &Category{Category: text}
+	case tokIdent:
+		if len(IdentifierWords(text)) == 0 {
+			return nil, 0, fmt.Errorf("the ident: atom must have an argument")
+		}
+		expr = &Identifier{Name: text}
+	case tokModified:
+		q, err := parseModified(text, time.Now())
+		if err != nil {
+			return nil, 0, err
+		}
+		expr = q
 	case tokMeta:
 		// Split on ':' to separate field and value
 		parts := bytes.SplitN([]byte(text), []byte(":"), 2)
@@ -265,6 +417,74 @@ func parseExpr(in []byte) (Q, int, error) {
 	return expr, len(in) - len(b), nil
 }
 
+// parseDate parses an absolute date like 2006-01-02 or an RFC 3339
+// timestamp, or a duration relative to now like 30d, 2w, 6m or 1y.
+func parseDate(text string, now time.Time) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", time.RFC3339, "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, text, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	if t, ok := parseRelativeDate(text, now); ok {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("query: invalid date %q, want YYYY-MM-DD, an RFC 3339 timestamp or a relative date like 30d, 2w, 6m, 1y", text)
+}
+
+// parseRelativeDate parses a duration relative to now like 30d, 2w, 6m or
+// 1y, and returns the time that long before now.
+func parseRelativeDate(text string, now time.Time) (time.Time, bool) {
+	if len(text) < 2 {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(text[:len(text)-1])
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	switch text[len(text)-1] {
+	case 'd':
+		return now.AddDate(0, 0, -n), true
+	case 'w':
+		return now.AddDate(0, 0, -7*n), true
+	case 'm':
+		return now.AddDate(0, -n, 0), true
+	case 'y':
+		return now.AddDate(-n, 0, 0), true
+	}
+	return time.Time{}, false
+}
+
+// parseModified parses the argument of modified:. With a relative date like
+// 30d, < and > compare the age of the last change, so modified:<30d matches
+// files changed in the last 30 days. With an absolute date they compare the
+// date of the last change, so modified:<2024-01-01 matches files not changed
+// since 2024. Without an operator, the date is a lower bound in both cases.
+func parseModified(text string, now time.Time) (*Modified, error) {
+	var op byte
+	if text != "" && (text[0] == '<' || text[0] == '>') {
+		op = text[0]
+		text = text[1:]
+	}
+
+	if t, ok := parseRelativeDate(text, now); ok {
+		if op == '>' {
+			return &Modified{Before: t}, nil
+		}
+		return &Modified{After: t}, nil
+	}
+
+	t, err := parseDate(text, now)
+	if err != nil {
+		return nil, err
+	}
+	if op == '<' {
+		return &Modified{Before: t}, nil
+	}
+	return &Modified{After: t}, nil
+}
+
 const regexpFlags syntax.Flags = syntax.ClassNL | syntax.PerlX | syntax.UnicodeGroups
 
 // RegexpQuery parses an atom into either a regular expression, or a
@@ -338,6 +558,11 @@ func parseExprList(in []byte) ([]Q, int, error) {
 			qs = append(qs, &orOperator{})
 			b = b[len(tok.Input):]
 			continue
+		} else if tok != nil && tok.Type == tokNear {
+			distance, _ := parseNearOperator(tok.Text)
+			qs = append(qs, &nearOperator{distance: distance})
+			b = b[len(tok.Input):]
+			continue
 		}
 
 		q, n, err := parseExpr(b)
@@ -353,6 +578,11 @@ func parseExprList(in []byte) ([]Q, int, error) {
 		b = b[n:]
 	}
 
+	qs, err := parseNearOperators(qs)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	setCase := "auto"
 	newQS := qs[:0]
 	typeT := uint8(100)
@@ -414,6 +644,19 @@ const (
 	tokPublic     = 16
 	tokFork       = 17
 	tokMeta       = 18
+	tokAuthor     = 19
+	tokBefore     = 20
+	tokAfter      = 21
+	tokMessage    = 22
+	tokModified   = 23
+	tokDef        = 24
+	tokRef        = 25
+	tokRefs       = 26
+	tokStruct     = 27
+	tokFuzzyFile  = 28
+	tokCategory   = 29
+	tokNear       = 30
+	tokIdent      = 31
 )
 
 var tokNames = map[int]string{
@@ -435,6 +678,19 @@ var tokNames = map[int]string{
 	tokSym:        "Symbol",
 	tokType:       "Type",
 	tokMeta:       "Meta",
+	tokAuthor:     "Author",
+	tokBefore:     "Before",
+	tokAfter:      "After",
+	tokMessage:    "Message",
+	tokModified:   "Modified",
+	tokDef:        "Def",
+	tokRef:        "Ref",
+	tokRefs:       "Refs",
+	tokStruct:     "Struct",
+	tokFuzzyFile:  "FuzzyFile",
+	tokCategory:   "Category",
+	tokNear:       "Near",
+	tokIdent:      "Ident",
 }
 
 var prefixes = map[string]int{
@@ -456,6 +712,18 @@ var prefixes = map[string]int{
 	"t:":        tokType,
 	"type:":     tokType,
 	"meta.":     tokMeta,
+	"author:":   tokAuthor,
+	"before:":   tokBefore,
+	"after:":    tokAfter,
+	"message:":  tokMessage,
+	"modified:": tokModified,
+	"def:":      tokDef,
+	"ref:":      tokRef,
+	"refs:":     tokRefs,
+	"struct:":   tokStruct,
+	"file~:":    tokFuzzyFile,
+	"category:": tokCategory,
+	"ident:":    tokIdent,
 }
 
 var reservedWords = map[string]int{
@@ -479,6 +747,9 @@ func (t *token) setType() {
 			break
 		}
 	}
+	if _, ok := parseNearOperator(t.Input); ok && string(t.Text) == string(t.Input) {
+		t.Type = tokNear
+	}
 
 	for pref, typ := range prefixes {
 		if !bytes.HasPrefix(t.Input, []byte(pref)) {
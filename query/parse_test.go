@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"regexp/syntax"
 	"testing"
+	"time"
 
 	"github.com/grafana/regexp"
 )
@@ -113,6 +114,45 @@ func TestParseQuery(t *testing.T) {
 		{"type:repo abc", &Type{Type: TypeRepo, Child: &Substring{Pattern: "abc"}}},
 		{"type:file abc def", &Type{Type: TypeFileName, Child: NewAnd(&Substring{Pattern: "abc"}, &Substring{Pattern: "def"})}},
 		{"(type:repo abc) def", NewAnd(&Type{Type: TypeRepo, Child: &Substring{Pattern: "abc"}}, &Substring{Pattern: "def"})},
+		{"type:commit abc", &Type{Type: TypeCommit, Child: &Substring{Pattern: "abc"}}},
+		{"type:commit", &Type{Type: TypeCommit, Child: &Const{Value: true}}},
+
+		// commits
+		{"author:bob", &Author{Regexp: regexp.MustCompile("(?i)bob")}},
+		{"author:Bob", &Author{Regexp: regexp.MustCompile("Bob")}},
+		{"message:fix.*bug", &Message{Regexp: mustParseRE("fix(?-s:.)*bug")}},
+		{"message:Fix", &Message{Regexp: mustParseRE("Fix"), CaseSensitive: true}},
+		{"after:2024-01-02", &CommitDate{After: time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local)}},
+		{"before:2024-01-02T10:00:00Z", &CommitDate{Before: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)}},
+
+		// occurrences
+		{"def:newFoo", &Occurrence{Regexp: regexp.MustCompile("newFoo"), Definition: true}},
+		{"ref:foo.*", &Occurrence{Regexp: regexp.MustCompile("(?i)foo.*")}},
+		{"refs:NewServer", &SymbolRefs{Name: "NewServer"}},
+		{`struct:"foo(:[a], \":[b]\")"`, &Structural{Pattern: `foo(:[a], ":[b]")`}},
+		{"struct:foo(:[a])", &Structural{Pattern: "foo(:[a])"}},
+		{"file~:srvmain", &FuzzyFileName{Pattern: "srvmain"}},
+		{"category:test", &Category{Category: "test"}},
+		{"-category:vendored", &Not{&Category{Category: "vendored"}}},
+		{"ident:getUserName", &Identifier{Name: "getUserName"}},
+
+		// near
+		{"lock NEAR/5 defer", &Near{Children: []Q{&Substring{Pattern: "lock"}, &Substring{Pattern: "defer"}}, Distance: 5}},
+		{"a NEAR/2 b NEAR/2 c", &Near{Children: []Q{&Substring{Pattern: "a"}, &Substring{Pattern: "b"}, &Substring{Pattern: "c"}}, Distance: 2}},
+		{"a NEAR/2 b NEAR/0 c", &Near{Children: []Q{
+			&Near{Children: []Q{&Substring{Pattern: "a"}, &Substring{Pattern: "b"}}, Distance: 2},
+			&Substring{Pattern: "c"},
+		}}},
+		{"x a NEAR/1 b or c", NewOr(
+			NewAnd(&Substring{Pattern: "x"}, &Near{Children: []Q{&Substring{Pattern: "a"}, &Substring{Pattern: "b"}}, Distance: 1}),
+			&Substring{Pattern: "c"},
+		)},
+		{"(a b) NEAR/3 f:c", &Near{Children: []Q{
+			NewAnd(&Substring{Pattern: "a"}, &Substring{Pattern: "b"}),
+			&Substring{Pattern: "c", FileName: true},
+		}, Distance: 3}},
+		{"a NEAR/x b", NewAnd(&Substring{Pattern: "a"}, &Substring{Pattern: "NEAR/x", CaseSensitive: true}, &Substring{Pattern: "b"})},
+		{`a "NEAR/3" b`, NewAnd(&Substring{Pattern: "a"}, &Substring{Pattern: "NEAR/3", CaseSensitive: true}, &Substring{Pattern: "b"})},
 
 		// errors.
 		{"--", nil},
@@ -121,6 +161,21 @@ func TestParseQuery(t *testing.T) {
 		{"case:foo", nil},
 
 		{"sym:", nil},
+		{"author:", nil},
+		{"message:", nil},
+		{"def:", nil},
+		{"ref:", nil},
+		{"refs:", nil},
+		{"struct:", nil},
+		{"file~:", nil},
+		{"category:tests", nil},
+		{"ident:", nil},
+		{"ident:__", nil},
+		{"NEAR/3 abc", nil},
+		{"abc NEAR/3", nil},
+		{"a NEAR/3 or b", nil},
+		{"-a NEAR/3 b", nil},
+		{"before:yesterday", nil},
 		{"abc or", nil},
 		{"or abc", nil},
 		{"def or or abc", nil},
@@ -200,6 +255,65 @@ func TestTokenize(t *testing.T) {
 	}
 }
 
+func TestParseDate(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		in   string
+		want time.Time
+	}{
+		{"2023-12-01", time.Date(2023, 12, 1, 0, 0, 0, 0, time.Local)},
+		{"2023-12-01T08:30:00Z", time.Date(2023, 12, 1, 8, 30, 0, 0, time.UTC)},
+		{"0d", now},
+		{"30d", time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{"2w", time.Date(2024, 3, 17, 12, 0, 0, 0, time.UTC)},
+		{"1m", time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC)},
+		{"1y", time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC)},
+	} {
+		got, err := parseDate(c.in, now)
+		if err != nil {
+			t.Errorf("parseDate(%q): %v", c.in, err)
+		} else if !got.Equal(c.want) {
+			t.Errorf("parseDate(%q): got %v, want %v", c.in, got, c.want)
+		}
+	}
+
+	for _, in := range []string{"", "d", "-1d", "3x", "2024-13-01"} {
+		if _, err := parseDate(in, now); err == nil {
+			t.Errorf("parseDate(%q): expected error", in)
+		}
+	}
+}
+
+func TestParseModified(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	monthAgo := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	newYear := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	for _, c := range []struct {
+		in   string
+		want *Modified
+	}{
+		{"30d", &Modified{After: monthAgo}},
+		{"<30d", &Modified{After: monthAgo}},
+		{">30d", &Modified{Before: monthAgo}},
+		{"2024-01-01", &Modified{After: newYear}},
+		{">2024-01-01", &Modified{After: newYear}},
+		{"<2024-01-01", &Modified{Before: newYear}},
+	} {
+		got, err := parseModified(c.in, now)
+		if err != nil {
+			t.Errorf("parseModified(%q): %v", c.in, err)
+		} else if !got.After.Equal(c.want.After) || !got.Before.Equal(c.want.Before) {
+			t.Errorf("parseModified(%q): got %v, want %v", c.in, got, c.want)
+		}
+	}
+
+	for _, in := range []string{"", "<", "<>30d", "30x"} {
+		if _, err := parseModified(in, now); err == nil {
+			t.Errorf("parseModified(%q): expected error", in)
+		}
+	}
+}
+
 func TestMetaQueryParsing(t *testing.T) {
 	cases := []struct {
 		input   string
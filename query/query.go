@@ -23,6 +23,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/grafana/regexp"
@@ -353,6 +355,7 @@ const (
 	TypeFileMatch uint8 = iota
 	TypeFileName
 	TypeRepo
+	TypeCommit
 )
 
 // Type changes the result type returned.
@@ -369,6 +372,8 @@ func (q *Type) String() string {
 		return fmt.Sprintf("(type:filename %s)", q.Child)
 	case TypeRepo:
 		return fmt.Sprintf("(type:repo %s)", q.Child)
+	case TypeCommit:
+		return fmt.Sprintf("(type:commit %s)", q.Child)
 	default:
 		return fmt.Sprintf("(type:UNKNOWN %s)", q.Child)
 	}
@@ -484,6 +489,22 @@ func (q *And) String() string {
 	return fmt.Sprintf("(and %s)", strings.Join(sub, " "))
 }
 
+// Near matches documents that have a content match of every child within
+// Distance lines of each other. A Distance of 0 requires the matches to be
+// on the same line.
+type Near struct {
+	Children []Q
+	Distance int
+}
+
+func (q *Near) String() string {
+	var sub []string
+	for _, ch := range q.Children {
+		sub = append(sub, ch.String())
+	}
+	return fmt.Sprintf("(near/%d %s)", q.Distance, strings.Join(sub, " "))
+}
+
 // NewAnd is syntactic sugar for constructing And queries.
 func NewAnd(qs ...Q) Q {
 	return &And{Children: qs}
@@ -520,6 +541,231 @@ func (m *Meta) String() string {
 	return fmt.Sprintf("meta.%s:%s", m.Field, m.Value)
 }
 
+// Author matches commits whose author, formatted as "Name <email>", matches
+// the regular expression. It only applies to commit documents.
+type Author struct {
+	Regexp *regexp.Regexp
+}
+
+func (q *Author) String() string {
+	return fmt.Sprintf("author:%q", q.Regexp)
+}
+
+// CommitDate matches commits with a commit date in the range [After,
+// Before). A zero bound leaves that side of the range open. It only applies
+// to commit documents.
+type CommitDate struct {
+	After  time.Time
+	Before time.Time
+}
+
+func (q *CommitDate) String() string {
+	var parts []string
+	if !q.After.IsZero() {
+		parts = append(parts, "after:"+q.After.Format(time.RFC3339))
+	}
+	if !q.Before.IsZero() {
+		parts = append(parts, "before:"+q.Before.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, " "))
+}
+
+// Modified matches files that were last changed in the range [After,
+// Before), according to the blame computed at index time: the most recent
+// commit that changed one of their lines counts. A zero bound leaves that
+// side of the range open. Files without blame never match.
+type Modified struct {
+	After  time.Time
+	Before time.Time
+}
+
+func (q *Modified) String() string {
+	var parts []string
+	if !q.After.IsZero() {
+		parts = append(parts, "after:"+q.After.Format(time.RFC3339))
+	}
+	if !q.Before.IsZero() {
+		parts = append(parts, "before:"+q.Before.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("modified:(%s)", strings.Join(parts, " "))
+}
+
+// Occurrence matches precise definitions of or references to symbols, as
+// recorded from a SCIP index at index time. Files without occurrences never
+// match.
+type Occurrence struct {
+	// Regexp matches the name of the symbol, e.g. "Foo" for the method
+	// "scip-go gomod example v1 `example/pkg`/Bar#Foo().".
+	Regexp *regexp.Regexp
+
+	// Symbol, if set, matches the full SCIP symbol exactly instead of its
+	// name.
+	Symbol string
+
+	// Definition selects definitions instead of references.
+	Definition bool
+}
+
+func (q *Occurrence) String() string {
+	kind := "ref"
+	if q.Definition {
+		kind = "def"
+	}
+	if q.Symbol != "" {
+		return fmt.Sprintf("%s:(symbol %q)", kind, q.Symbol)
+	}
+	return fmt.Sprintf("%s:%q", kind, q.Regexp)
+}
+
+// SymbolRefs matches the occurrences of the identifier Name as a whole word
+// that aren't definitions according to the symbol information of the
+// index. Files in or importing the package that defines Name rank higher.
+type SymbolRefs struct {
+	Name string
+
+	// Definitions holds the definitions of Name that rank the references.
+	// Searchers look them up before searching, so that the definitions in
+	// every shard count. If nil, they are looked up in the searched shard.
+	Definitions []SymbolDefinition
+}
+
+// SymbolDefinition is a definition of the identifier of a SymbolRefs query.
+type SymbolDefinition struct {
+	Repository string
+
+	// Dir is the directory of the file containing the definition.
+	Dir string
+
+	// Package is the name of the package defining the identifier, or "" if
+	// it is unknown.
+	Package string
+}
+
+func (q *SymbolRefs) String() string {
+	return fmt.Sprintf("refs:%q", q.Name)
+}
+
+// Structural matches a comby-style structural pattern, like
+// `foo(:[a], ":[b]")`, against the content of files.
+type Structural struct {
+	Pattern string
+}
+
+func (q *Structural) String() string {
+	return fmt.Sprintf("struct:%q", q.Pattern)
+}
+
+// FuzzyFileName matches file names that contain the characters of Pattern
+// in order, or that are similar to it, like the "go to file" pickers of
+// editors. Files are ranked by how well their name matches.
+type FuzzyFileName struct {
+	Pattern string
+}
+
+func (q *FuzzyFileName) String() string {
+	return fmt.Sprintf("file~:%q", q.Pattern)
+}
+
+// FileCategories are the categories that files are classified into during
+// indexing, as accepted by Category.
+var FileCategories = []string{"default", "test", "vendored", "generated", "config", "dotfile", "binary", "documentation"}
+
+// Category matches files of a category, like "test" or "vendored". It is
+// one of FileCategories.
+type Category struct {
+	Category string
+}
+
+func (q *Category) String() string {
+	return "category:" + q.Category
+}
+
+// Identifier matches the identifier Name in content regardless of its case
+// style, so that getUserName also finds get_user_name and get-user-name.
+type Identifier struct {
+	Name string
+}
+
+func (q *Identifier) String() string {
+	return fmt.Sprintf("ident:%q", q.Name)
+}
+
+// IdentifierWords splits an identifier into its lower cased words. Words are
+// separated by characters that aren't letters or digits, and by changes of
+// case, like in camelCase or HTTPServer.
+func IdentifierWords(name string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(word) > 0 {
+			prev := word[len(word)-1]
+			// A new word starts at the upper case letter of "userName", and at
+			// the last upper case letter of an acronym like "HTTPServer".
+			if !unicode.IsUpper(prev) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// Message matches a regular expression against the message of commits. It
+// only applies to commit documents.
+type Message struct {
+	Regexp        *syntax.Regexp
+	CaseSensitive bool
+}
+
+func (q *Message) String() string {
+	pref := ""
+	if q.CaseSensitive {
+		pref = "case_"
+	}
+	return fmt.Sprintf("%smessage:%q", pref, syntaxutil.RegexpString(q.Regexp))
+}
+
+func (q *Message) setCase(k string) {
+	switch k {
+	case "yes":
+		q.CaseSensitive = true
+	case "no":
+		q.CaseSensitive = false
+	case "auto":
+		q.CaseSensitive = !q.Regexp.Equal(LowerRegexp(q.Regexp))
+	}
+}
+
+// IsCommitQuery returns true if q searches commits rather than files, ie. it
+// contains type:commit or one of the atoms that only apply to commits.
+func IsCommitQuery(q Q) bool {
+	found := false
+	Map(q, func(q Q) Q {
+		switch s := q.(type) {
+		case *Type:
+			found = found || s.Type == TypeCommit
+		case *Author, *CommitDate, *Message:
+			found = true
+		}
+		return q
+	})
+	return found
+}
+
 func queryChildren(q Q) []Q {
 	switch s := q.(type) {
 	case *And:
@@ -574,6 +820,15 @@ func flatten(q Q) (Q, bool) {
 	case *Boost:
 		child, changed := flatten(s.Child)
 		return &Boost{Child: child, Boost: s.Boost}, changed
+	case *Near:
+		var children []Q
+		changed := false
+		for _, ch := range s.Children {
+			ch, subChanged := flatten(ch)
+			changed = changed || subChanged
+			children = append(children, ch)
+		}
+		return &Near{Children: children, Distance: s.Distance}, changed
 	default:
 		return q, false
 	}
@@ -635,6 +890,11 @@ func evalConstants(q Q) Q {
 		return &Not{ch}
 	case *Type:
 		ch := evalConstants(s.Child)
+		if c, ok := ch.(*Const); ok && c.Value && s.Type == TypeCommit {
+			// Unlike the other types, type:commit restricts which documents
+			// match, so folding it would return files instead.
+			return &Type{Child: ch, Type: s.Type}
+		}
 		if _, ok := ch.(*Const); ok {
 			// If q is the root query, then evaluating this to a const changes
 			// the type of result we will return. However, the only case this
@@ -651,6 +911,16 @@ func evalConstants(q Q) Q {
 			return ch
 		}
 		return &Boost{Boost: s.Boost, Child: ch}
+	case *Near:
+		// Constants have no matches to be near of, so only a false child
+		// decides the outcome.
+		children := mapQueryList(s.Children, evalConstants)
+		for _, ch := range children {
+			if c, ok := ch.(*Const); ok && !c.Value {
+				return ch
+			}
+		}
+		return &Near{Children: children, Distance: s.Distance}
 	case *Substring:
 		if len(s.Pattern) == 0 {
 			return &Const{true}
@@ -712,10 +982,21 @@ func Map(q Q, f func(q Q) Q) Q {
 		q = &Type{Type: s.Type, Child: Map(s.Child, f)}
 	case *Boost:
 		q = &Boost{Boost: s.Boost, Child: Map(s.Child, f)}
+	case *Near:
+		q = &Near{Children: mapQueryList(s.Children, f), Distance: s.Distance}
 	}
 	return f(q)
 }
 
+// FuzzyFileNames converts literal file name atoms, like file:foo, to
+// FuzzyFileName. It implements SearchOptions.FuzzyFileNames.
+func FuzzyFileNames(q Q) Q {
+	if s, ok := q.(*Substring); ok && s.FileName && !s.Content {
+		return &FuzzyFileName{Pattern: s.Pattern}
+	}
+	return q
+}
+
 // Expand expands Substr queries into (OR file_substr content_substr)
 // queries, and the same for Regexp queries..
 func ExpandFileContent(q Q) Q {
@@ -753,6 +1034,7 @@ func VisitAtoms(q Q, v func(q Q)) {
 		case *Not:
 		case *Type:
 		case *Boost:
+		case *Near:
 		default:
 			v(iQ)
 		}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/grafana/regexp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	webserverv1 "github.com/sourcegraph/zoekt/grpc/protos/zoekt/webserver/v1"
 )
@@ -40,6 +41,8 @@ func QToProto(q Q) *webserverv1.Q {
 		return &webserverv1.Q{Query: &webserverv1.Q_Substring{Substring: v.ToProto()}}
 	case *And:
 		return &webserverv1.Q{Query: &webserverv1.Q_And{And: v.ToProto()}}
+	case *Near:
+		return &webserverv1.Q{Query: &webserverv1.Q_Near{Near: v.ToProto()}}
 	case *Or:
 		return &webserverv1.Q{Query: &webserverv1.Q_Or{Or: v.ToProto()}}
 	case *Not:
@@ -48,6 +51,26 @@ func QToProto(q Q) *webserverv1.Q {
 		return &webserverv1.Q{Query: &webserverv1.Q_Branch{Branch: v.ToProto()}}
 	case *Boost:
 		return &webserverv1.Q{Query: &webserverv1.Q_Boost{Boost: v.ToProto()}}
+	case *Modified:
+		return &webserverv1.Q{Query: &webserverv1.Q_Modified{Modified: v.ToProto()}}
+	case *Occurrence:
+		return &webserverv1.Q{Query: &webserverv1.Q_Occurrence{Occurrence: v.ToProto()}}
+	case *SymbolRefs:
+		return &webserverv1.Q{Query: &webserverv1.Q_SymbolRefs{SymbolRefs: v.ToProto()}}
+	case *Structural:
+		return &webserverv1.Q{Query: &webserverv1.Q_Structural{Structural: v.ToProto()}}
+	case *FuzzyFileName:
+		return &webserverv1.Q{Query: &webserverv1.Q_FuzzyFileName{FuzzyFileName: v.ToProto()}}
+	case *Category:
+		return &webserverv1.Q{Query: &webserverv1.Q_Category{Category: v.ToProto()}}
+	case *Identifier:
+		return &webserverv1.Q{Query: &webserverv1.Q_Identifier{Identifier: v.ToProto()}}
+	case *Author:
+		return &webserverv1.Q{Query: &webserverv1.Q_Author{Author: v.ToProto()}}
+	case *CommitDate:
+		return &webserverv1.Q{Query: &webserverv1.Q_CommitDate{CommitDate: v.ToProto()}}
+	case *Message:
+		return &webserverv1.Q{Query: &webserverv1.Q_Message{Message: v.ToProto()}}
 	default:
 		// The following nodes do not have a proto representation:
 		// - caseQ: only used internally, not by the RPC layer
@@ -85,6 +108,8 @@ func QFromProto(p *webserverv1.Q) (Q, error) {
 		return SubstringFromProto(v.Substring), nil
 	case *webserverv1.Q_And:
 		return AndFromProto(v.And)
+	case *webserverv1.Q_Near:
+		return NearFromProto(v.Near)
 	case *webserverv1.Q_Or:
 		return OrFromProto(v.Or)
 	case *webserverv1.Q_Not:
@@ -95,6 +120,26 @@ func QFromProto(p *webserverv1.Q) (Q, error) {
 		return BoostFromProto(v.Boost)
 	case *webserverv1.Q_Meta:
 		return MetaFromProto(v.Meta)
+	case *webserverv1.Q_Modified:
+		return ModifiedFromProto(v.Modified), nil
+	case *webserverv1.Q_Occurrence:
+		return OccurrenceFromProto(v.Occurrence)
+	case *webserverv1.Q_SymbolRefs:
+		return SymbolRefsFromProto(v.SymbolRefs), nil
+	case *webserverv1.Q_Structural:
+		return StructuralFromProto(v.Structural), nil
+	case *webserverv1.Q_FuzzyFileName:
+		return FuzzyFileNameFromProto(v.FuzzyFileName), nil
+	case *webserverv1.Q_Category:
+		return CategoryFromProto(v.Category), nil
+	case *webserverv1.Q_Identifier:
+		return IdentifierFromProto(v.Identifier), nil
+	case *webserverv1.Q_Author:
+		return AuthorFromProto(v.Author)
+	case *webserverv1.Q_CommitDate:
+		return CommitDateFromProto(v.CommitDate), nil
+	case *webserverv1.Q_Message:
+		return MessageFromProto(v.Message)
 	default:
 		panic(fmt.Sprintf("unknown query node %T", p.Query))
 	}
@@ -298,6 +343,8 @@ func TypeFromProto(p *webserverv1.Type) (*Type, error) {
 		kind = TypeFileName
 	case webserverv1.Type_KIND_REPO:
 		kind = TypeRepo
+	case webserverv1.Type_KIND_COMMIT:
+		kind = TypeCommit
 	}
 
 	return &Type{
@@ -316,6 +363,10 @@ func (q *Type) ToProto() *webserverv1.Type {
 		kind = webserverv1.Type_KIND_FILE_NAME
 	case TypeRepo:
 		kind = webserverv1.Type_KIND_REPO
+	case TypeCommit:
+		kind = webserverv1.Type_KIND_COMMIT
+	default:
+		panic(fmt.Sprintf("type %s has no proto representation", q))
 	}
 
 	return &webserverv1.Type{
@@ -388,6 +439,146 @@ func MetaFromProto(p *webserverv1.Meta) (*Meta, error) {
 	}, nil
 }
 
+func ModifiedFromProto(p *webserverv1.Modified) *Modified {
+	var q Modified
+	if p.GetAfter() != nil {
+		q.After = p.GetAfter().AsTime()
+	}
+	if p.GetBefore() != nil {
+		q.Before = p.GetBefore().AsTime()
+	}
+	return &q
+}
+
+func (q *Modified) ToProto() *webserverv1.Modified {
+	var p webserverv1.Modified
+	if !q.After.IsZero() {
+		p.After = timestamppb.New(q.After)
+	}
+	if !q.Before.IsZero() {
+		p.Before = timestamppb.New(q.Before)
+	}
+	return &p
+}
+
+func OccurrenceFromProto(p *webserverv1.Occurrence) (*Occurrence, error) {
+	q := &Occurrence{
+		Symbol:     p.GetSymbol(),
+		Definition: p.GetDefinition(),
+	}
+	if q.Symbol == "" {
+		r, err := regexp.Compile(p.GetRegexp())
+		if err != nil {
+			return nil, err
+		}
+		q.Regexp = r
+	}
+	return q, nil
+}
+
+func (q *Occurrence) ToProto() *webserverv1.Occurrence {
+	p := &webserverv1.Occurrence{
+		Symbol:     q.Symbol,
+		Definition: q.Definition,
+	}
+	if q.Regexp != nil {
+		p.Regexp = q.Regexp.String()
+	}
+	return p
+}
+
+func SymbolRefsFromProto(p *webserverv1.SymbolRefs) *SymbolRefs {
+	return &SymbolRefs{Name: p.GetName()}
+}
+
+func (q *SymbolRefs) ToProto() *webserverv1.SymbolRefs {
+	return &webserverv1.SymbolRefs{Name: q.Name}
+}
+
+func StructuralFromProto(p *webserverv1.Structural) *Structural {
+	return &Structural{Pattern: p.GetPattern()}
+}
+
+func (q *Structural) ToProto() *webserverv1.Structural {
+	return &webserverv1.Structural{Pattern: q.Pattern}
+}
+
+func FuzzyFileNameFromProto(p *webserverv1.FuzzyFileName) *FuzzyFileName {
+	return &FuzzyFileName{Pattern: p.GetPattern()}
+}
+
+func (q *FuzzyFileName) ToProto() *webserverv1.FuzzyFileName {
+	return &webserverv1.FuzzyFileName{Pattern: q.Pattern}
+}
+
+func CategoryFromProto(p *webserverv1.Category) *Category {
+	return &Category{Category: p.GetCategory()}
+}
+
+func (q *Category) ToProto() *webserverv1.Category {
+	return &webserverv1.Category{Category: q.Category}
+}
+
+func IdentifierFromProto(p *webserverv1.Identifier) *Identifier {
+	return &Identifier{Name: p.GetName()}
+}
+
+func (q *Identifier) ToProto() *webserverv1.Identifier {
+	return &webserverv1.Identifier{Name: q.Name}
+}
+
+func AuthorFromProto(p *webserverv1.Author) (*Author, error) {
+	r, err := regexp.Compile(p.GetRegexp())
+	if err != nil {
+		return nil, err
+	}
+	return &Author{Regexp: r}, nil
+}
+
+func (q *Author) ToProto() *webserverv1.Author {
+	return &webserverv1.Author{Regexp: q.Regexp.String()}
+}
+
+func CommitDateFromProto(p *webserverv1.CommitDate) *CommitDate {
+	var q CommitDate
+	if p.GetAfter() != nil {
+		q.After = p.GetAfter().AsTime()
+	}
+	if p.GetBefore() != nil {
+		q.Before = p.GetBefore().AsTime()
+	}
+	return &q
+}
+
+func (q *CommitDate) ToProto() *webserverv1.CommitDate {
+	var p webserverv1.CommitDate
+	if !q.After.IsZero() {
+		p.After = timestamppb.New(q.After)
+	}
+	if !q.Before.IsZero() {
+		p.Before = timestamppb.New(q.Before)
+	}
+	return &p
+}
+
+func MessageFromProto(p *webserverv1.Message) (*Message, error) {
+	parsed, err := syntax.Parse(p.GetRegexp(), regexpFlags)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Regexp:        parsed,
+		CaseSensitive: p.GetCaseSensitive(),
+	}, nil
+}
+
+func (q *Message) ToProto() *webserverv1.Message {
+	return &webserverv1.Message{
+		Regexp:        q.Regexp.String(),
+		CaseSensitive: q.CaseSensitive,
+	}
+}
+
 func (q *Boost) ToProto() *webserverv1.Boost {
 	return &webserverv1.Boost{
 		Child: QToProto(q.Child),
@@ -435,6 +626,32 @@ func (q *And) ToProto() *webserverv1.And {
 	}
 }
 
+func NearFromProto(p *webserverv1.Near) (*Near, error) {
+	children := make([]Q, len(p.GetChildren()))
+	for i, child := range p.GetChildren() {
+		c, err := QFromProto(child)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = c
+	}
+	return &Near{
+		Children: children,
+		Distance: int(p.GetDistance()),
+	}, nil
+}
+
+func (q *Near) ToProto() *webserverv1.Near {
+	children := make([]*webserverv1.Q, len(q.Children))
+	for i, child := range q.Children {
+		children[i] = QToProto(child)
+	}
+	return &webserverv1.Near{
+		Children: children,
+		Distance: int64(q.Distance),
+	}
+}
+
 func BranchFromProto(p *webserverv1.Branch) *Branch {
 	return &Branch{
 		Pattern: p.GetPattern(),
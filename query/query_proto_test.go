@@ -3,6 +3,7 @@ package query
 import (
 	"regexp/syntax"
 	"testing"
+	"time"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/google/go-cmp/cmp"
@@ -87,6 +88,35 @@ func TestQueryRoundtrip(t *testing.T) {
 			},
 			Boost: 20,
 		},
+		&Modified{
+			After: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		&Occurrence{
+			Regexp:     regexp.MustCompile("(?i)foo"),
+			Definition: true,
+		},
+		&Occurrence{
+			Symbol: "scip-go gomod example v1 `example/pkg`/Foo().",
+		},
+		&SymbolRefs{Name: "NewServer"},
+		&Structural{Pattern: `foo(:[a], ":[b]")`},
+		&FuzzyFileName{Pattern: "srvmain"},
+		&Category{Category: "test"},
+		&Identifier{Name: "getUserName"},
+		&Near{Children: []Q{&Substring{Pattern: "lock()"}, &Substring{Pattern: "defer"}}, Distance: 5},
+		&Type{
+			Child: &And{Children: []Q{
+				&Author{Regexp: regexp.MustCompile("(?i)alice")},
+				&Message{Regexp: regexpMustParse("fix.*crash"), CaseSensitive: true},
+				&CommitDate{
+					After:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					Before: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+				&CommitDate{Before: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+				&Substring{Pattern: "oldFunc", Content: true},
+			}},
+			Type: TypeCommit,
+		},
 	}
 
 	for _, q := range testCases {
@@ -103,6 +133,25 @@ func TestQueryRoundtrip(t *testing.T) {
 	}
 }
 
+func TestCommitQueryRoundtrip(t *testing.T) {
+	for _, in := range []string{
+		"type:commit author:alice message:fix before:2024-01-01 after:2023-06-01 oldFunc",
+		"type:commit case:yes message:Revert -author:bot",
+	} {
+		q, err := Parse(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q2, err := QFromProto(QToProto(q))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(q.String(), q2.String()); diff != "" {
+			t.Errorf("%s: unexpected diff: %s", in, diff)
+		}
+	}
+}
+
 func regexpMustParse(s string) *syntax.Regexp {
 	re, err := syntax.Parse(s, syntax.Perl)
 	if err != nil {
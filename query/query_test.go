@@ -20,6 +20,7 @@ import (
 	"regexp/syntax"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/grafana/regexp"
 )
 
@@ -178,3 +179,25 @@ func TestExpandFileContent(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentifierWords(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want []string
+	}{
+		{"getUserName", []string{"get", "user", "name"}},
+		{"GetUserName", []string{"get", "user", "name"}},
+		{"get_user_name", []string{"get", "user", "name"}},
+		{"GET_USER_NAME", []string{"get", "user", "name"}},
+		{"get-user-name", []string{"get", "user", "name"}},
+		{"HTTPServer", []string{"http", "server"}},
+		{"parseHTTP2Frame", []string{"parse", "http2", "frame"}},
+		{"utf8", []string{"utf8"}},
+		{"__init__", []string{"init"}},
+		{"--", nil},
+	} {
+		if d := cmp.Diff(tc.want, IdentifierWords(tc.in)); d != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tc.in, d)
+		}
+	}
+}
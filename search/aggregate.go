@@ -27,6 +27,9 @@ var metricFinalAggregateSize = promauto.NewHistogramVec(prometheus.HistogramOpts
 type collectSender struct {
 	opts      *zoekt.SearchOptions
 	aggregate *zoekt.SearchResult
+
+	// truncated is true if the display limits of opts dropped files.
+	truncated bool
 }
 
 func newCollectSender(opts *zoekt.SearchOptions) *collectSender {
@@ -44,11 +47,20 @@ func (c *collectSender) Send(r *zoekt.SearchResult) {
 	}
 
 	c.aggregate.Stats.Add(r.Stats)
+	c.aggregate.AddFacets(r.Facets)
+	c.aggregate.AddCaptures(r.Captures)
 
 	if len(r.Files) > 0 {
 		c.aggregate.Files = append(c.aggregate.Files, r.Files...)
+		if c.opts.CollapseDuplicates {
+			// Shards only collapse their own files, so we collapse across
+			// shards here.
+			c.aggregate.Files = index.CollapseDuplicates(c.aggregate.Files)
+		}
 
+		n := len(c.aggregate.Files)
 		c.aggregate.Files = index.SortAndTruncateFiles(c.aggregate.Files, c.opts)
+		c.truncated = c.truncated || len(c.aggregate.Files) < n
 
 		maps.Copy(c.aggregate.RepoURLs, r.RepoURLs)
 		maps.Copy(c.aggregate.LineFragments, r.LineFragments)
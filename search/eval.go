@@ -67,6 +67,26 @@ func (s *typeRepoSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zo
 	}))
 }
 
+func (s *typeRepoSearcher) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (ex *zoekt.Explanation, err error) {
+	tr, ctx := trace.New(ctx, "typeRepoSearcher.Explain", "")
+	tr.LazyLog(q, true)
+	tenant.Log(ctx, tr)
+	defer func() {
+		if err != nil {
+			tr.LazyPrintf("error: %v", err)
+			tr.SetError(err)
+		}
+		tr.Finish()
+	}()
+
+	q, err = s.eval(ctx, tr, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Streamer.Explain(ctx, q, opts)
+}
+
 func (s *typeRepoSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (rl *zoekt.RepoList, err error) {
 	tr, ctx := trace.New(ctx, "typeRepoSearcher.List", "")
 	tr.LazyLog(q, true)
@@ -16,10 +16,10 @@ package search
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
-	"os"
 	"runtime"
 	"runtime/debug"
 	"slices"
@@ -53,6 +53,10 @@ var (
 		Name: "zoekt_shards_load_failed_total",
 		Help: "The total number of shard loads that failed",
 	})
+	metricShardsQuarantined = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "zoekt_shards_quarantined",
+		Help: "The number of shards in the quarantine directory of the index directory",
+	})
 
 	metricSearchRunning = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "zoekt_search_running",
@@ -325,7 +329,11 @@ func (tl *loader) load(keys ...string) {
 			shard, err := loadShard(key)
 			if err != nil {
 				metricShardsLoadFailedTotal.Inc()
-				log.Printf("[ERROR] reloading: %s, err %v ", key, err)
+				if errors.Is(err, index.ErrCorrupt) {
+					log.Printf("[ERROR] skipping corrupt shard %s, err %v", key, err)
+				} else {
+					log.Printf("[ERROR] reloading: %s, err %v ", key, err)
+				}
 				return
 			}
 			metricShardsLoadedTotal.Inc()
@@ -575,6 +583,13 @@ func (ss *shardedSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.Se
 	}
 
 	copyFiles(aggregate)
+	if opts != nil {
+		aggregate.TruncateCaptures(opts.MaxCaptureValues)
+
+		if opts.Paginated() && collectSender.truncated && len(aggregate.Files) > 0 {
+			aggregate.Cursor = zoekt.CursorFor(&aggregate.Files[len(aggregate.Files)-1]).Encode()
+		}
+	}
 
 	if !loaded.ready {
 		// We may have missed results due to not being fully loaded.
@@ -597,6 +612,18 @@ func (ss *shardedSearcher) StreamSearch(ctx context.Context, q query.Q, opts *zo
 		tr.Finish()
 	}()
 
+	// A page holds the first results of all shards in cursor order, so it is
+	// only known once all shards are searched. Paginated searches send their
+	// page, with the cursor of the next one, as a single result.
+	if opts != nil && opts.Paginated() {
+		sr, err := ss.Search(ctx, q, opts)
+		if err != nil {
+			return err
+		}
+		sender.Send(sr)
+		return nil
+	}
+
 	start := time.Now()
 	proc, err := ss.sched.Acquire(ctx)
 	if err != nil {
@@ -682,6 +709,16 @@ func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.Sea
 		tr.Finish()
 	}()
 
+	// Paginated searches skip the results up to and including the cursor.
+	var after *zoekt.Cursor
+	if opts != nil && opts.Cursor != "" {
+		c, err := zoekt.ParseCursor(opts.Cursor)
+		if err != nil {
+			return func() {}, err
+		}
+		after = &c
+	}
+
 	// Select the subset of shards that we will search over for the given query.
 	{
 		beforeLen := len(shards)
@@ -694,6 +731,24 @@ func streamSearch(ctx context.Context, proc *process, q query.Q, opts *zoekt.Sea
 		return func() {}, nil
 	}
 
+	// refs: queries rank references by the definitions of the identifier in
+	// all shards, not just in the shard of the reference.
+	q, err = index.ResolveSymbolRefs(ctx, q, func(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+		sender := newCollectSender(opts)
+		done, err := streamSearch(ctx, proc, q, opts, shards, sender)
+		defer done()
+		if err != nil {
+			return nil, err
+		}
+		if res, ok := sender.Done(); ok {
+			return res, nil
+		}
+		return &zoekt.SearchResult{}, nil
+	})
+	if err != nil {
+		return func() {}, err
+	}
+
 	var cancel context.CancelFunc
 	if opts.MaxWallTime == 0 {
 		ctx, cancel = context.WithCancel(ctx)
@@ -807,6 +862,19 @@ search:
 
 			observeMetrics(r.SearchResult)
 
+			if after != nil {
+				// Drop the files of the previous pages from the results and
+				// their stats.
+				r.Files = slices.DeleteFunc(r.Files, func(fm zoekt.FileMatch) bool {
+					if zoekt.CursorFor(&fm).Compare(*after) > 0 {
+						return false
+					}
+					r.Stats.FileCount--
+					r.Stats.MatchCount -= fileMatchCount(&fm)
+					return true
+				})
+			}
+
 			r.Priority = r.priority
 			r.MaxPendingPriority = pending.max()
 
@@ -817,6 +885,16 @@ search:
 	return func() { runtime.KeepAlive(shards) }, err
 }
 
+// fileMatchCount returns the number of matches of fm, as counted by
+// zoekt.Stats.MatchCount.
+func fileMatchCount(fm *zoekt.FileMatch) int {
+	n := len(fm.LineMatches)
+	for _, cm := range fm.ChunkMatches {
+		n += len(cm.Ranges)
+	}
+	return n
+}
+
 // sendByRepository splits a zoekt.SearchResult by repository and calls
 // sender.Send for each batch. Ranking in Sourcegraph expects zoekt.SearchResult
 // to contain results with the same zoekt.SearchResult.priority only.
@@ -830,7 +908,7 @@ func sendByRepository(result *zoekt.SearchResult, opts *zoekt.SearchOptions, sen
 		return
 	}
 
-	send := func(repoName string, a, b int, stats zoekt.Stats) {
+	send := func(repoName string, a, b int, last bool) {
 		index.SortFiles(result.Files[a:b])
 
 		filteredRepoURLs := map[string]string{repoName: result.RepoURLs[repoName]}
@@ -855,8 +933,7 @@ func sendByRepository(result *zoekt.SearchResult, opts *zoekt.SearchOptions, sen
 			}
 		}
 
-		sender.Send(&zoekt.SearchResult{
-			Stats: stats,
+		sr := &zoekt.SearchResult{
 			Progress: zoekt.Progress{
 				Priority:           result.Files[a].RepositoryPriority,
 				MaxPendingPriority: result.MaxPendingPriority,
@@ -864,7 +941,17 @@ func sendByRepository(result *zoekt.SearchResult, opts *zoekt.SearchOptions, sen
 			Files:         result.Files[a:b],
 			RepoURLs:      filteredRepoURLs,
 			LineFragments: filteredLineFragments,
-		})
+		}
+
+		// Stats, facets and captures must stay aggregate-able, hence we send
+		// them with the last event.
+		if last {
+			sr.Stats = result.Stats
+			sr.Facets = result.Facets
+			sr.Captures = result.Captures
+		}
+
+		sender.Send(sr)
 	}
 
 	var startIndex, endIndex int
@@ -874,9 +961,7 @@ func sendByRepository(result *zoekt.SearchResult, opts *zoekt.SearchOptions, sen
 	fm := zoekt.FileMatch{}
 	for endIndex, fm = range result.Files {
 		if curRepoID != fm.RepositoryID {
-			// Stats must stay aggregate-able, hence we sent the aggregate stats with the
-			// last event.
-			send(curRepoName, startIndex, endIndex, zoekt.Stats{})
+			send(curRepoName, startIndex, endIndex, false)
 
 			startIndex = endIndex
 			curRepoID = fm.RepositoryID
@@ -884,7 +969,7 @@ func sendByRepository(result *zoekt.SearchResult, opts *zoekt.SearchOptions, sen
 		}
 	}
 
-	send(curRepoName, startIndex, endIndex+1, result.Stats)
+	send(curRepoName, startIndex, endIndex+1, true)
 }
 
 func observeMetrics(sr *zoekt.SearchResult) {
@@ -965,6 +1050,191 @@ func listOneShard(ctx context.Context, s zoekt.Searcher, q query.Q, opts *zoekt.
 	sink <- shardListResult{ms, err}
 }
 
+// References searches all shards for the references to symbol.
+func (ss *shardedSearcher) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	return ss.Search(ctx, &query.Occurrence{Symbol: symbol}, opts)
+}
+
+// Explain searches the shards selected for q and collects how each of them
+// evaluated it. Unlike Search, it doesn't stop early because of match limits.
+func (ss *shardedSearcher) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (ex *zoekt.Explanation, err error) {
+	tr, ctx := trace.New(ctx, "shardedSearcher.Explain", "")
+	tr.LazyLog(q, true)
+	defer func() {
+		if err != nil {
+			tr.LazyPrintf("error: %v", err)
+			tr.SetError(err)
+		}
+		tr.Finish()
+	}()
+
+	start := time.Now()
+	proc, err := ss.sched.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer proc.Release()
+	tr.LazyPrintf("acquired process")
+
+	if opts == nil {
+		opts = &zoekt.SearchOptions{}
+	}
+	if opts.MaxWallTime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.MaxWallTime)
+		defer cancel()
+	}
+
+	loaded := ss.getLoaded()
+	shards, q := selectRepoSet(loaded.shards, query.Simplify(q))
+
+	ex = &zoekt.Explanation{Query: q.String()}
+	if !loaded.ready {
+		// We may have missed results due to not being fully loaded.
+		ex.Stats.Crashes++
+	}
+
+	selected := make(map[*rankedShard]bool, len(shards))
+	for _, s := range shards {
+		selected[s] = true
+	}
+	for _, s := range loaded.shards {
+		if !selected[s] {
+			ex.SkippedShards = append(ex.SkippedShards, s.String())
+		}
+	}
+
+	// Explanations are collected by index so that they are in the order of
+	// the shards.
+	results := make([]*zoekt.Explanation, len(shards))
+	errs := make([]error, len(shards))
+	feeder := make(chan int, len(shards))
+	for i := range shards {
+		feeder <- i
+	}
+	close(feeder)
+
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(shards)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range feeder {
+				results[i], errs[i] = explainOneShard(ctx, shards[i], q, opts)
+			}
+		}()
+	}
+	wg.Wait()
+	runtime.KeepAlive(shards)
+
+	for i, r := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		ex.Shards = append(ex.Shards, r.Shards...)
+		ex.Stats.Add(r.Stats)
+	}
+	ex.Duration = time.Since(start)
+
+	return ex, nil
+}
+
+func explainOneShard(ctx context.Context, s zoekt.Searcher, q query.Q, opts *zoekt.SearchOptions) (ex *zoekt.Explanation, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Printf("[ERROR] crashed shard: %s: %#v, %s", s, e, debug.Stack())
+
+			crashed := zoekt.ShardExplanation{Shard: s.String()}
+			crashed.Stats.Crashes = 1
+			ex = &zoekt.Explanation{
+				Shards: []zoekt.ShardExplanation{crashed},
+				Stats:  crashed.Stats,
+			}
+		}
+	}()
+
+	return s.Explain(ctx, q, opts)
+}
+
+// Clones compares content with the documents of all shards and returns the
+// most similar ones.
+func (ss *shardedSearcher) Clones(ctx context.Context, content []byte, opts *zoekt.CloneOptions) (cr *zoekt.CloneResult, err error) {
+	tr, ctx := trace.New(ctx, "shardedSearcher.Clones", "")
+	tr.LazyPrintf("content.size=%d", len(content))
+	defer func() {
+		if cr != nil {
+			tr.LazyPrintf("clones.size=%d stats=%+v", len(cr.Clones), cr.Stats)
+		}
+		if err != nil {
+			tr.LazyPrintf("error: %v", err)
+			tr.SetError(err)
+		}
+		tr.Finish()
+	}()
+
+	start := time.Now()
+	proc, err := ss.sched.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer proc.Release()
+	tr.LazyPrintf("acquired process")
+
+	loaded := ss.getLoaded()
+	shards := loaded.shards
+
+	cr = &zoekt.CloneResult{}
+	if !loaded.ready {
+		// We may have missed results due to not being fully loaded.
+		cr.Stats.Crashes++
+	}
+
+	results := make([]*zoekt.CloneResult, len(shards))
+	errs := make([]error, len(shards))
+	feeder := make(chan int, len(shards))
+	for i := range shards {
+		feeder <- i
+	}
+	close(feeder)
+
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(shards)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range feeder {
+				results[i], errs[i] = clonesOneShard(ctx, shards[i], content, opts)
+			}
+		}()
+	}
+	wg.Wait()
+	runtime.KeepAlive(shards)
+
+	for i, r := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		cr.Clones = append(cr.Clones, r.Clones...)
+		cr.Stats.Add(r.Stats)
+	}
+	cr.Clones = index.SortAndTruncateClones(cr.Clones, opts)
+	cr.Stats.Duration = time.Since(start)
+
+	return cr, nil
+}
+
+func clonesOneShard(ctx context.Context, s zoekt.Searcher, content []byte, opts *zoekt.CloneOptions) (cr *zoekt.CloneResult, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Printf("[ERROR] crashed shard: %s: %#v, %s", s, e, debug.Stack())
+			cr = &zoekt.CloneResult{}
+			cr.Stats.Crashes = 1
+		}
+	}()
+
+	return s.Clones(ctx, content, opts)
+}
+
 func (ss *shardedSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (rl *zoekt.RepoList, err error) {
 	tr, ctx := trace.New(ctx, "shardedSearcher.List", "")
 	metricListRunning.Inc()
@@ -1233,20 +1503,25 @@ func (s *shardedSearcher) replace(shards map[string]zoekt.Searcher) {
 	metricShardsLoaded.Set(float64(len(ranked)))
 }
 
-func loadShard(fn string) (zoekt.Searcher, error) {
-	f, err := os.Open(fn)
+func loadShard(fn string) (_ zoekt.Searcher, err error) {
+	iFile, err := index.OpenIndexFile(fn)
 	if err != nil {
 		return nil, err
 	}
 
-	iFile, err := index.NewIndexFile(f)
-	if err != nil {
-		return nil, err
-	}
+	// A corrupt shard may make NewSearcher panic. Report it like other
+	// errors so that it doesn't crash the process.
+	defer func() {
+		if r := recover(); r != nil {
+			iFile.Close()
+			err = fmt.Errorf("NewSearcher(%s): %w: %v", fn, index.ErrCorrupt, r)
+		}
+	}()
+
 	s, err := index.NewSearcher(iFile)
 	if err != nil {
 		iFile.Close()
-		return nil, fmt.Errorf("NewSearcher(%s): %v", fn, err)
+		return nil, fmt.Errorf("NewSearcher(%s): %w", fn, err)
 	}
 
 	return s, nil
@@ -23,7 +23,10 @@ import (
 	"io"
 	"log"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"sort"
@@ -61,6 +64,18 @@ func (s *crashSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOpt
 	panic("list")
 }
 
+func (s *crashSearcher) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	panic("references")
+}
+
+func (s *crashSearcher) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.Explanation, error) {
+	panic("explain")
+}
+
+func (s *crashSearcher) Clones(ctx context.Context, content []byte, opts *zoekt.CloneOptions) (*zoekt.CloneResult, error) {
+	panic("clones")
+}
+
 func (s *crashSearcher) Stats() (*zoekt.RepoStats, error) {
 	return &zoekt.RepoStats{}, nil
 }
@@ -93,6 +108,18 @@ func TestCrashResilience(t *testing.T) {
 		} else if res.Crashes != wantCrashes {
 			t.Errorf("got result %#v, want crashes = %d", res, wantCrashes)
 		}
+
+		if ex, err := ss.Explain(context.Background(), q, opts); err != nil {
+			t.Fatalf("Explain: %v", err)
+		} else if ex.Stats.Crashes != wantCrashes {
+			t.Errorf("got stats %#v, want crashes = %d", ex.Stats, wantCrashes)
+		}
+
+		if cr, err := ss.Clones(context.Background(), []byte("content"), nil); err != nil {
+			t.Fatalf("Clones: %v", err)
+		} else if cr.Stats.Crashes != wantCrashes {
+			t.Errorf("got stats %#v, want crashes = %d", cr.Stats, wantCrashes)
+		}
 	}
 
 	// Before we are marked as ready we have one extra crash
@@ -141,6 +168,18 @@ func (s *rankSearcher) Search(ctx context.Context, q query.Q, opts *zoekt.Search
 	}, nil
 }
 
+func (s *rankSearcher) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	return s.Search(ctx, &query.Occurrence{Symbol: symbol}, opts)
+}
+
+func (s *rankSearcher) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.Explanation, error) {
+	return &zoekt.Explanation{Shards: []zoekt.ShardExplanation{{Shard: s.String()}}}, nil
+}
+
+func (s *rankSearcher) Clones(ctx context.Context, content []byte, opts *zoekt.CloneOptions) (*zoekt.CloneResult, error) {
+	return &zoekt.CloneResult{}, nil
+}
+
 func (s *rankSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
 	r := zoekt.Repository{}
 	if s.repo != nil {
@@ -297,6 +336,325 @@ func TestShardedSearcher_DocumentRanking(t *testing.T) {
 	}
 }
 
+func TestShardedSearcher_Facets(t *testing.T) {
+	ss := newShardedSearcher(1)
+	for i, repo := range []string{"a", "b"} {
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo},
+			index.Document{Name: "cmd/main.go", Content: []byte("needle")},
+			index.Document{Name: "pkg/lib.go", Content: []byte("needle")},
+		)
+		ss.replace(map[string]zoekt.Searcher{
+			fmt.Sprintf("key-%d", i): searcherForTest(t, b),
+		})
+	}
+
+	q := &query.Substring{Pattern: "needle"}
+	want := &zoekt.Facets{
+		Repositories: map[string]int{"a": 2, "b": 2},
+		Languages:    map[string]int{"Go": 4},
+		Categories:   map[string]int{"default": 4},
+		Directories:  map[string]int{"cmd": 2, "pkg": 2},
+	}
+
+	// The facets count all matches, not just the displayed ones.
+	res, err := ss.Search(context.Background(), q, &zoekt.SearchOptions{Facets: true, MaxDocDisplayCount: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 {
+		t.Fatalf("got %d files, want 1", len(res.Files))
+	}
+	if d := cmp.Diff(want, res.Facets); d != "" {
+		t.Errorf("Search: mismatch (-want +got):\n%s", d)
+	}
+
+	var agg zoekt.SearchResult
+	err = ss.StreamSearch(context.Background(), q, &zoekt.SearchOptions{Facets: true},
+		zoekt.SenderFunc(func(event *zoekt.SearchResult) {
+			agg.AddFacets(event.Facets)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, agg.Facets); d != "" {
+		t.Errorf("StreamSearch: mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestShardedSearcher_Captures(t *testing.T) {
+	ss := newShardedSearcher(1)
+	for i, repo := range []string{"a", "b"} {
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo},
+			index.Document{Name: "main.go", Content: []byte("import \"github.com/x/y\"\nimport \"github.com/" + repo + "/z\"\n")},
+		)
+		ss.replace(map[string]zoekt.Searcher{
+			fmt.Sprintf("key-%d", i): searcherForTest(t, b),
+		})
+	}
+
+	q := &query.Substring{Pattern: "import"}
+	opts := &zoekt.SearchOptions{CaptureRegexp: `"(github\.com/[^"]+)"`}
+
+	res, err := ss.Search(context.Background(), q, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int{"github.com/x/y": 2, "github.com/a/z": 1, "github.com/b/z": 1}
+	if d := cmp.Diff(want, res.Captures); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+
+	// The counts are truncated after collating the results of all shards.
+	opts.MaxCaptureValues = 2
+	res, err = ss.Search(context.Background(), q, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]int{"github.com/x/y": 2, "github.com/a/z": 1}
+	if d := cmp.Diff(want, res.Captures); d != "" {
+		t.Errorf("MaxCaptureValues: mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestShardedSearcher_Pagination(t *testing.T) {
+	ss := newShardedSearcher(1)
+	for i, repo := range []string{"a", "b"} {
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo},
+			index.Document{Name: "1.go", Content: []byte("needle")},
+			index.Document{Name: "2.go", Content: []byte("needle\nneedle")},
+			index.Document{Name: "3.go", Content: []byte("needle")},
+		)
+		ss.replace(map[string]zoekt.Searcher{
+			fmt.Sprintf("key-%d", i): searcherForTest(t, b),
+		})
+	}
+
+	q := &query.Substring{Pattern: "needle", Content: true}
+	all, err := ss.Search(context.Background(), q, &zoekt.SearchOptions{Paginate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.Cursor != "" {
+		t.Fatalf("got cursor %q for untruncated results", all.Cursor)
+	}
+	var want []string
+	for _, f := range all.Files {
+		want = append(want, f.Repository+"/"+f.FileName)
+	}
+
+	matchCount := func(f zoekt.FileMatch) int {
+		n := 0
+		for _, lm := range f.LineMatches {
+			n += len(lm.LineFragments)
+		}
+		return n
+	}
+
+	streamSearch := func(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+		var results []*zoekt.SearchResult
+		err := ss.StreamSearch(ctx, q, opts, zoekt.SenderFunc(func(sr *zoekt.SearchResult) {
+			results = append(results, sr)
+		}))
+		if len(results) != 1 {
+			t.Fatalf("got %d streamed results, want 1", len(results))
+		}
+		return results[0], err
+	}
+
+	for name, search := range map[string]func(context.Context, query.Q, *zoekt.SearchOptions) (*zoekt.SearchResult, error){
+		"Search":       ss.Search,
+		"StreamSearch": streamSearch,
+	} {
+		for _, opts := range []zoekt.SearchOptions{
+			{Paginate: true, MaxDocDisplayCount: 4},
+			// The match limit is reached in the middle of the first 2.go, which
+			// still ends the page with both its matches.
+			{Paginate: true, MaxMatchDisplayCount: 3},
+		} {
+			var got []string
+			matches := 0
+			for page := 0; ; page++ {
+				if page > len(want) {
+					t.Fatalf("%s %+v: pagination didn't stop, got %v", name, opts, got)
+				}
+				res, err := search(context.Background(), q, &opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// The stats count the files of this and the following pages.
+				if res.Stats.FileCount != len(want)-len(got) || res.Stats.MatchCount != 8-matches {
+					t.Errorf("%s %+v: page %d has FileCount %d and MatchCount %d, want %d and %d", name, opts, page, res.Stats.FileCount, res.Stats.MatchCount, len(want)-len(got), 8-matches)
+				}
+				for _, f := range res.Files {
+					got = append(got, f.Repository+"/"+f.FileName)
+					matches += matchCount(f)
+				}
+				if res.Cursor == "" {
+					break
+				}
+				opts.Cursor = res.Cursor
+			}
+			if d := cmp.Diff(want, got); d != "" {
+				t.Errorf("%s %+v: mismatch (-want +got):\n%s", name, opts, d)
+			}
+			if matches != 8 {
+				t.Errorf("%s %+v: got %d matches, want 8", name, opts, matches)
+			}
+		}
+	}
+
+	if _, err := ss.Search(context.Background(), q, &zoekt.SearchOptions{Cursor: "garbage"}); err == nil {
+		t.Error("got no error for invalid cursor")
+	}
+}
+
+func TestShardedSearcher_CollapseDuplicates(t *testing.T) {
+	ss := newShardedSearcher(1)
+	for i, repo := range []string{"a", "b", "c"} {
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo},
+			index.Document{Name: "lib.go", Content: []byte("needle")},
+			index.Document{Name: repo + ".go", Content: []byte("needle in " + repo)},
+		)
+		ss.replace(map[string]zoekt.Searcher{
+			fmt.Sprintf("key-%d", i): searcherForTest(t, b),
+		})
+	}
+
+	q := &query.Substring{Pattern: "needle", Content: true}
+	res, err := ss.Search(context.Background(), q, &zoekt.SearchOptions{CollapseDuplicates: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string][]string{}
+	for _, f := range res.Files {
+		name := f.Repository + "/" + f.FileName
+		got[name] = []string{}
+		for _, l := range f.AlsoIn {
+			got[name] = append(got[name], l.Repository+"/"+l.FileName)
+		}
+	}
+
+	// The copies of lib.go score the same, so any of them may remain.
+	var lib string
+	for name, alsoIn := range got {
+		if len(alsoIn) > 0 {
+			lib = name
+		}
+	}
+	want := map[string][]string{
+		"a/a.go": {},
+		"b/b.go": {},
+		"c/c.go": {},
+	}
+	for _, repo := range []string{"a", "b", "c"} {
+		if name := repo + "/lib.go"; name != lib {
+			want[lib] = append(want[lib], name)
+		}
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+	if res.Stats.FileCount != 6 {
+		t.Errorf("got FileCount %d, want 6", res.Stats.FileCount)
+	}
+}
+
+func TestShardedSearcher_SymbolRefs(t *testing.T) {
+	ss := newShardedSearcher(1)
+	shards := map[string][]index.Document{
+		"lib": {{
+			Name:            "server/server.go",
+			Content:         []byte("package server\n\nfunc NewServer() {}\n"),
+			Symbols:         []index.DocumentSection{{Start: 21, End: 30}},
+			SymbolsMetaData: []*zoekt.Symbol{{Sym: "NewServer", Kind: "function"}},
+		}},
+		// The references are in another shard than the definition.
+		"app": {
+			{Name: "a/other.go", Content: []byte("package a\n\nimport \"example.com/other\"\n\nvar s = NewServer()\n")},
+			{Name: "b/main.go", Content: []byte("package b\n\nimport \"example.com/server\"\n\nvar s = server.NewServer()\n")},
+		},
+	}
+	for repo, docs := range shards {
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo}, docs...)
+		ss.replace(map[string]zoekt.Searcher{repo: searcherForTest(t, b)})
+	}
+
+	res, err := ss.Search(context.Background(), &query.SymbolRefs{Name: "NewServer"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, f := range res.Files {
+		got = append(got, f.Repository+"/"+f.FileName)
+	}
+	// The file importing the package of the definition ranks first.
+	want := []string{"app/b/main.go", "app/a/other.go"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestShardedSearcher_Explain(t *testing.T) {
+	ss := newShardedSearcher(1)
+	for i, repo := range []string{"a", "b"} {
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo},
+			index.Document{Name: "main.go", Content: []byte("needle")},
+		)
+		ss.replace(map[string]zoekt.Searcher{
+			fmt.Sprintf("key-%d", i): searcherForTest(t, b),
+		})
+	}
+
+	q := query.NewAnd(
+		query.NewRepoSet("a"),
+		&query.Substring{Pattern: "needle", Content: true},
+	)
+	ex, err := ss.Explain(context.Background(), q, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ex.Shards) != 1 || len(ex.SkippedShards) != 1 {
+		t.Fatalf("got %d shards and %d skipped shards, want 1 and 1", len(ex.Shards), len(ex.SkippedShards))
+	}
+	if ex.Stats.MatchCount != 1 {
+		t.Errorf("got stats %+v, want MatchCount 1", ex.Stats)
+	}
+	if len(ex.Shards[0].Ngrams) != 1 || ex.Shards[0].Ngrams[0].Pattern != "needle" {
+		t.Errorf("got ngrams %+v, want the ngrams of needle", ex.Shards[0].Ngrams)
+	}
+}
+
+func TestShardedSearcher_Clones(t *testing.T) {
+	original := []byte("func add(a, b int) int {\n\treturn a + b\n}\n")
+	ss := newShardedSearcher(1)
+	for i, repo := range []string{"a", "b", "c"} {
+		content := original
+		if repo == "c" {
+			content = []byte("package other\n\nvar unrelated = map[string]int{}\n")
+		}
+		b := testShardBuilder(t, &zoekt.Repository{ID: hash(repo), Name: repo},
+			index.Document{Name: "add.go", Content: content},
+		)
+		ss.replace(map[string]zoekt.Searcher{
+			fmt.Sprintf("key-%d", i): searcherForTest(t, b),
+		})
+	}
+
+	cr, err := ss.Clones(context.Background(), original, &zoekt.CloneOptions{MaxResults: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []zoekt.Clone{{Repository: "a", FileName: "add.go", Language: "Go", Similarity: 1}}
+	if d := cmp.Diff(want, cr.Clones, cmpopts.IgnoreFields(zoekt.Clone{}, "Branches")); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+	if cr.Stats.ShardsScanned != 3 || cr.Stats.FilesConsidered != 3 {
+		t.Errorf("got stats %+v, want 3 shards and files", cr.Stats)
+	}
+}
+
 func TestFilteringShardsByRepoSetOrBranchesReposOrRepoIDs(t *testing.T) {
 	ss := newShardedSearcher(1)
 
@@ -578,12 +936,12 @@ func (s *memSeeker) Name() string {
 }
 
 func (s *memSeeker) Close() {}
-func (s *memSeeker) Read(off, sz uint32) ([]byte, error) {
-	return s.data[off : off+sz], nil
+func (s *memSeeker) Read(off uint64, sz uint32) ([]byte, error) {
+	return s.data[off : off+uint64(sz)], nil
 }
 
-func (s *memSeeker) Size() (uint32, error) {
-	return uint32(len(s.data)), nil
+func (s *memSeeker) Size() (uint64, error) {
+	return uint64(len(s.data)), nil
 }
 
 func TestUnloadIndex(t *testing.T) {
@@ -1403,6 +1761,37 @@ func TestNewDirectorySearcher_empty(t *testing.T) {
 	})
 }
 
+// Shards can be served from a static HTTP file server.
+func TestNewDirectorySearcher_HTTP(t *testing.T) {
+	dir := t.TempDir()
+	b := testShardBuilder(t, &zoekt.Repository{Name: "remote"},
+		index.Document{Name: "f1", Content: []byte("a needle in a haystack")},
+		index.Document{Name: "f2", Content: []byte("just hay")})
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "remote_v16.00000.zoekt"), buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer srv.Close()
+
+	ss, err := NewDirectorySearcher(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+
+	res, err := ss.Search(context.Background(), &query.Substring{Pattern: "needle"}, &zoekt.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 || res.Files[0].FileName != "f1" || res.Files[0].Repository != "remote" {
+		t.Fatalf("got %v, want remote/f1", res.Files)
+	}
+}
+
 // testDeadline returns the deadline for t, but ensures it is no longer than
 // maxTimeout away.
 func testDeadline(t *testing.T, maxTimeout time.Duration) time.Time {
@@ -17,8 +17,8 @@ package search
 import (
 	"fmt"
 	"log"
-	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -41,6 +41,10 @@ type DirectoryWatcher struct {
 	timestamps map[string]time.Time
 	loader     shardLoader
 
+	// quarantined holds the shards in the quarantine directory as of the
+	// last scan. They are never loaded.
+	quarantined []string
+
 	// closed once ready
 	ready    chan struct{}
 	readyErr error
@@ -120,13 +124,13 @@ func versionFromPath(path string) (string, int) {
 func (s *DirectoryWatcher) scan() error {
 	// NOTE: if you change which file extensions are read, please update the
 	// watch implementation.
-	fs, err := filepath.Glob(filepath.Join(s.dir, "*.zoekt"))
+	mtimes, err := index.ListIndexFiles(s.dir)
 	if err != nil {
 		return err
 	}
 
 	latest := map[string]int{}
-	for _, fn := range fs {
+	for fn := range mtimes {
 		name, version := versionFromPath(fn)
 
 		// In the case of downgrades, avoid reading
@@ -141,25 +145,11 @@ func (s *DirectoryWatcher) scan() error {
 	}
 
 	ts := map[string]time.Time{}
-	for _, fn := range fs {
+	for fn, mtime := range mtimes {
 		if name, version := versionFromPath(fn); latest[name] != version {
 			continue
 		}
-
-		fi, err := os.Lstat(fn)
-		if err != nil {
-			continue
-		}
-
-		ts[fn] = fi.ModTime()
-
-		fiMeta, err := os.Lstat(fn + ".meta")
-		if err != nil {
-			continue
-		}
-		if fiMeta.ModTime().After(fi.ModTime()) {
-			ts[fn] = fiMeta.ModTime()
-		}
+		ts[fn] = mtime
 	}
 
 	var toLoad []string
@@ -186,9 +176,36 @@ func (s *DirectoryWatcher) scan() error {
 	s.loader.drop(toDrop...)
 	s.loader.load(toLoad...)
 
+	s.scanQuarantine()
+
 	return nil
 }
 
+// scanQuarantine reports the shards that were moved to the quarantine
+// directory, for example by zoekt-verify, if they changed since the last
+// scan.
+func (s *DirectoryWatcher) scanQuarantine() {
+	localDir, ok := index.LocalPath(s.dir)
+	if !ok {
+		return
+	}
+	dir := filepath.Join(localDir, index.QuarantineDir)
+	fs, err := filepath.Glob(filepath.Join(dir, "*.zoekt"))
+	if err != nil {
+		return
+	}
+	sort.Strings(fs)
+	if slices.Equal(fs, s.quarantined) {
+		return
+	}
+
+	s.quarantined = fs
+	metricShardsQuarantined.Set(float64(len(fs)))
+	if len(fs) > 0 {
+		log.Printf("[WARN] %d quarantined shard(s) in %s: %s", len(fs), dir, humanTruncateList(fs, 5))
+	}
+}
+
 func humanTruncateList(paths []string, max int) string {
 	sort.Strings(paths)
 	var b strings.Builder
@@ -206,12 +223,20 @@ func humanTruncateList(paths []string, max int) string {
 }
 
 func (s *DirectoryWatcher) watch() error {
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		return err
-	}
-	if err := watcher.Add(s.dir); err != nil {
-		return err
+	// Shards that aren't local are only rescanned periodically.
+	var watcher *fsnotify.Watcher
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	if localDir, ok := index.LocalPath(s.dir); ok {
+		var err error
+		watcher, err = fsnotify.NewWatcher()
+		if err != nil {
+			return err
+		}
+		if err := watcher.Add(localDir); err != nil {
+			return err
+		}
+		events, errs = watcher.Events, watcher.Errors
 	}
 
 	// intermediate signal channel so if there are multiple watcher.Events we
@@ -230,7 +255,7 @@ func (s *DirectoryWatcher) watch() error {
 
 		for {
 			select {
-			case event := <-watcher.Events:
+			case event := <-events:
 				// Only notify if a file we read in has changed. This is important to
 				// avoid all the events writing to temporary files.
 				if strings.HasSuffix(event.Name, ".zoekt") || strings.HasSuffix(event.Name, ".meta") {
@@ -241,7 +266,7 @@ func (s *DirectoryWatcher) watch() error {
 				// Periodically just double check the disk
 				notify()
 
-			case err := <-watcher.Errors:
+			case err := <-errs:
 				// Ignore ErrEventOverflow since we rely on the presence of events so
 				// safe to ignore.
 				if err != nil && err != fsnotify.ErrEventOverflow {
@@ -249,7 +274,9 @@ func (s *DirectoryWatcher) watch() error {
 				}
 
 			case <-s.quit:
-				watcher.Close()
+				if watcher != nil {
+					watcher.Close()
+				}
 				ticker.Stop()
 				close(signal)
 				return
@@ -131,6 +131,49 @@ func TestDirWatcherLoadEmpty(t *testing.T) {
 	}
 }
 
+func TestDirWatcherQuarantine(t *testing.T) {
+	dir := t.TempDir()
+
+	logger := &loggingLoader{
+		loads: make(chan string, 10),
+		drops: make(chan string, 10),
+	}
+
+	shard := filepath.Join(dir, "foo.zoekt")
+	if err := os.WriteFile(shard, []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	quarantined := filepath.Join(dir, index.QuarantineDir, "bar.zoekt")
+	if err := os.Mkdir(filepath.Dir(quarantined), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(quarantined, []byte("corrupt"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	dw, err := newDirectoryWatcher(dir, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dw.Stop()
+	if err := dw.WaitUntilReady(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := <-logger.loads; got != shard {
+		t.Fatalf("got load event %v, want %v", got, shard)
+	}
+	select {
+	case k := <-logger.loads:
+		t.Errorf("spurious load of %q", k)
+	default:
+	}
+
+	if got, want := dw.quarantined, []string{quarantined}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("got quarantined %v, want %v", got, want)
+	}
+}
+
 func TestVersionFromPath(t *testing.T) {
 	cases := map[string]struct {
 		name    string
@@ -35,6 +35,12 @@ type LastInput struct {
 
 	// If true, the next search will run in debug mode.
 	Debug bool
+
+	// If true, file: atoms match file names fuzzily.
+	FuzzyFileNames bool
+
+	// If true, files with identical content are collapsed into one result.
+	CollapseDuplicates bool
 }
 
 // Result holds the data provided to the search results template.
@@ -45,6 +51,25 @@ type ResultInput struct {
 	Stats       zoekt.Stats
 	Duration    time.Duration
 	FileMatches []*FileMatch
+
+	// Facets counts all matching files, not just FileMatches.
+	Facets []FacetGroup `json:",omitempty"`
+}
+
+// FacetGroup holds the most common values of a facet of the search results,
+// ordered by count.
+type FacetGroup struct {
+	Name   string
+	Values []FacetValue
+}
+
+// FacetValue is the value of a facet with the number of matching files.
+type FacetValue struct {
+	Value string
+	Count int
+
+	// Atom is a query atom restricting the search to Value.
+	Atom string `json:",omitempty"`
 }
 
 // FileMatch holds the per file data provided to search results template
@@ -58,6 +83,10 @@ type FileMatch struct {
 	// of the first index.
 	DuplicateID string
 
+	// The other files with the same content, if duplicates were
+	// collapsed.
+	AlsoIn []FileLocation `json:",omitempty"`
+
 	Branches []string
 	Matches  []Match
 	URL      string
@@ -67,6 +96,13 @@ type FileMatch struct {
 	ScoreDebug string  `json:"-"`
 }
 
+// FileLocation is a file that a FileMatch was collapsed with.
+type FileLocation struct {
+	Repo     string
+	FileName string
+	URL      string
+}
+
 // Match holds the per line data provided to the search results template
 type Match struct {
 	URL      string
@@ -77,6 +113,9 @@ type Match struct {
 	Before    string `json:",omitempty"`
 	After     string `json:",omitempty"`
 
+	// SyntaxTokens classifies the tokens of the line, relative to its start.
+	SyntaxTokens []zoekt.SyntaxToken `json:"-"`
+
 	// Don't expose to caller of JSON API
 	Score      float64 `json:"-"`
 	ScoreDebug string  `json:"-"`
@@ -43,12 +43,12 @@ type memSeeker struct {
 }
 
 func (s *memSeeker) Close() {}
-func (s *memSeeker) Read(off, sz uint32) ([]byte, error) {
-	return s.data[off : off+sz], nil
+func (s *memSeeker) Read(off uint64, sz uint32) ([]byte, error) {
+	return s.data[off : off+uint64(sz)], nil
 }
 
-func (s *memSeeker) Size() (uint32, error) {
-	return uint32(len(s.data)), nil
+func (s *memSeeker) Size() (uint64, error) {
+	return uint64(len(s.data)), nil
 }
 
 func (s *memSeeker) Name() string {
@@ -327,6 +327,60 @@ func TestFormatJson(t *testing.T) {
 	checkResultMatches(t, ts, "/search?q=water&format=json", expected)
 }
 
+func TestFacets(t *testing.T) {
+	b, err := index.NewShardBuilder(&zoekt.Repository{Name: "name"})
+	if err != nil {
+		t.Fatalf("NewShardBuilder: %v", err)
+	}
+	if err := b.Add(index.Document{Name: "f2", Language: "Go", Content: []byte("to carry water")}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	srv := Server{
+		Searcher: searcherForTest(t, b),
+		Top:      Top,
+		HTML:     true,
+	}
+
+	mux, err := NewMux(&srv)
+	if err != nil {
+		t.Fatalf("NewMux: %v", err)
+	}
+
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	get := func(req string) []byte {
+		t.Helper()
+		res, err := http.Get(ts.URL + req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return body
+	}
+
+	if body := get("/search?q=water"); !bytes.Contains(body, []byte("<b>Languages:</b>")) {
+		t.Errorf("results page has no facets: %s", body)
+	}
+
+	for req, want := range map[string]bool{
+		"/search?q=water&format=json":             false,
+		"/search?q=water&format=json&facets=true": true,
+	} {
+		var result ApiSearchResult
+		if err := json.Unmarshal(get(req), &result); err != nil {
+			t.Fatal(err)
+		}
+		if got := len(result.Result.Facets) > 0; got != want {
+			t.Errorf("%s: got facets %v, want %t", req, result.Result.Facets, want)
+		}
+	}
+}
+
 func TestContextLines(t *testing.T) {
 	b, err := index.NewShardBuilder(&zoekt.Repository{
 		Name:     "name",
@@ -848,6 +902,58 @@ func TestDupResult(t *testing.T) {
 	}
 }
 
+func TestCollapsedDupResult(t *testing.T) {
+	b, err := index.NewShardBuilder(&zoekt.Repository{
+		Name: "name",
+	})
+	if err != nil {
+		t.Fatalf("NewShardBuilder: %v", err)
+	}
+
+	for i := range 2 {
+		if err := b.Add(index.Document{
+			Name:    fmt.Sprintf("file%d", i),
+			Content: []byte("bla"),
+		}); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+	s := searcherForTest(t, b)
+	srv := Server{
+		Searcher: s,
+		Top:      Top,
+		HTML:     true,
+	}
+
+	mux, err := NewMux(&srv)
+	if err != nil {
+		t.Fatalf("NewMux: %v", err)
+	}
+
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/search?q=bla&dups=true")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resultBytes, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	got := string(resultBytes)
+	for _, want := range []string{"Also in 1 more", "name:file1"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %s, want substring %q", got, want)
+		}
+	}
+	if strings.Contains(got, "Duplicate result") {
+		t.Errorf("got %s, want no duplicate results", got)
+	}
+}
+
 func TestTruncateLine(t *testing.T) {
 	b, err := index.NewShardBuilder(&zoekt.Repository{
 		Name: "name",
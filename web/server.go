@@ -68,6 +68,7 @@ var Funcmap = template.FuncMap{
 
 		return fmt.Sprintf("%d%s", b, suffix)
 	},
+	"HighlightFragments": highlightFragments,
 	"LimitPre": func(limit int, pre string) string {
 		if len(pre) < limit {
 			return pre
@@ -302,6 +303,11 @@ func (s *Server) serveSearchErr(r *http.Request) (*ApiSearchResult, error) {
 	qvals := r.URL.Query()
 
 	debugScore, _ := strconv.ParseBool(qvals.Get("debug"))
+	fuzzyFileNames, _ := strconv.ParseBool(qvals.Get("fuzzy"))
+	collapseDuplicates, _ := strconv.ParseBool(qvals.Get("dups"))
+	// The results page shows facets, JSON callers have to ask for them.
+	facets, _ := strconv.ParseBool(qvals.Get("facets"))
+	facets = facets || qvals.Get("format") != "json"
 
 	queryStr := qvals.Get("q")
 	if queryStr == "" {
@@ -342,7 +348,9 @@ func (s *Server) serveSearchErr(r *http.Request) (*ApiSearchResult, error) {
 	}
 
 	sOpts := zoekt.SearchOptions{
-		MaxWallTime: 10 * time.Second,
+		MaxWallTime:  10 * time.Second,
+		ChunkMatches: true,
+		SyntaxTokens: true,
 	}
 
 	numCtxLines := 0
@@ -357,6 +365,9 @@ func (s *Server) serveSearchErr(r *http.Request) (*ApiSearchResult, error) {
 	sOpts.SetDefaults()
 	sOpts.MaxDocDisplayCount = num
 	sOpts.DebugScore = debugScore
+	sOpts.FuzzyFileNames = fuzzyFileNames
+	sOpts.CollapseDuplicates = collapseDuplicates
+	sOpts.Facets = facets
 
 	ctx := r.Context()
 	if err := zjson.CalculateDefaultSearchLimits(ctx, q, s.Searcher, &sOpts); err != nil {
@@ -390,6 +401,7 @@ func (s *Server) serveSearchErr(r *http.Request) (*ApiSearchResult, error) {
 		Query:       q.String(),
 		QueryStr:    queryStr,
 		FileMatches: fileMatches,
+		Facets:      facetGroups(result.Facets),
 	}
 	if res.Stats.Wait < res.Stats.Duration/10 {
 		// Suppress queueing stats if they are neglible.
@@ -397,9 +409,56 @@ func (s *Server) serveSearchErr(r *http.Request) (*ApiSearchResult, error) {
 	}
 
 	res.Last.Debug = debugScore
+	res.Last.FuzzyFileNames = fuzzyFileNames
+	res.Last.CollapseDuplicates = collapseDuplicates
 	return &ApiSearchResult{Result: &res}, nil
 }
 
+// maxFacetValues is the number of values shown per facet.
+const maxFacetValues = 10
+
+// facetGroups returns the most common values of each facet.
+func facetGroups(f *zoekt.Facets) []FacetGroup {
+	if f == nil {
+		return nil
+	}
+
+	var groups []FacetGroup
+	add := func(name string, counts map[string]int, atom func(string) string) {
+		if len(counts) == 0 {
+			return
+		}
+		values := make([]FacetValue, 0, len(counts))
+		for v, n := range counts {
+			values = append(values, FacetValue{Value: v, Count: n, Atom: atom(v)})
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if values[i].Count != values[j].Count {
+				return values[i].Count > values[j].Count
+			}
+			return values[i].Value < values[j].Value
+		})
+		if len(values) > maxFacetValues {
+			values = values[:maxFacetValues]
+		}
+		groups = append(groups, FacetGroup{Name: name, Values: values})
+	}
+
+	add("Repositories", f.Repositories, func(v string) string {
+		return "repo:^" + regexp.QuoteMeta(v) + "$"
+	})
+	add("Languages", f.Languages, func(v string) string {
+		return "lang:" + strconv.Quote(v)
+	})
+	add("Categories", f.Categories, func(v string) string {
+		return "category:" + v
+	})
+	add("Directories", f.Directories, func(v string) string {
+		return "file:^" + regexp.QuoteMeta(v) + "/"
+	})
+	return groups
+}
+
 func (s *Server) servePrint(w http.ResponseWriter, r *http.Request) {
 	err := s.servePrintErr(w, r)
 	if err != nil {
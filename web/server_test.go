@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/zoekt"
 )
 
 func TestAddLineNumbers(t *testing.T) {
@@ -94,3 +96,25 @@ func TestAddLineNumbers(t *testing.T) {
 		})
 	}
 }
+
+func TestFacetGroups(t *testing.T) {
+	f := &zoekt.Facets{
+		Repositories: map[string]int{"b.com/x": 1, "a.com/y": 1, "c.com/z": 3},
+		Languages:    map[string]int{"Go": 2},
+		Categories:   map[string]int{"test": 1},
+		Directories:  map[string]int{"cmd": 1},
+	}
+	want := []FacetGroup{
+		{Name: "Repositories", Values: []FacetValue{
+			{Value: "c.com/z", Count: 3, Atom: `repo:^c\.com/z$`},
+			{Value: "a.com/y", Count: 1, Atom: `repo:^a\.com/y$`},
+			{Value: "b.com/x", Count: 1, Atom: `repo:^b\.com/x$`},
+		}},
+		{Name: "Languages", Values: []FacetValue{{Value: "Go", Count: 2, Atom: `lang:"Go"`}}},
+		{Name: "Categories", Values: []FacetValue{{Value: "test", Count: 1, Atom: "category:test"}}},
+		{Name: "Directories", Values: []FacetValue{{Value: "cmd", Count: 1, Atom: "file:^cmd/"}}},
+	}
+	if d := cmp.Diff(want, facetGroups(f)); d != "" {
+		t.Errorf("mismatch (-want +got):\n%s", d)
+	}
+}
@@ -16,6 +16,8 @@ package web
 
 import (
 	"bytes"
+	"fmt"
+	htmltemplate "html/template"
 	"log"
 	"net/url"
 	"strconv"
@@ -25,6 +27,10 @@ import (
 	"github.com/sourcegraph/zoekt"
 )
 
+// fragmentContextLimit is the number of bytes shown before and after a
+// match on its line.
+const fragmentContextLimit = 100
+
 func (s *Server) formatResults(result *zoekt.SearchResult, query string, localPrint bool, reposByName map[string]*zoekt.Repository) ([]*FileMatch, error) {
 	var fmatches []*FileMatch
 
@@ -122,16 +128,28 @@ func (s *Server) formatResults(result *zoekt.SearchResult, query string, localPr
 			fMatch.URL = getURL(f.Repository, f.FileName, f.Branches, f.Version)
 		}
 
-		for _, m := range f.LineMatches {
-			fragment := getFragment(f.Repository, m.LineNumber)
+		for _, l := range f.AlsoIn {
+			fMatch.AlsoIn = append(fMatch.AlsoIn, FileLocation{
+				Repo:     l.Repository,
+				FileName: l.FileName,
+				URL:      getURL(l.Repository, l.FileName, l.Branches, l.Version),
+			})
+		}
+
+		matchURL := func(lineNum int) string {
+			fragment := getFragment(f.Repository, lineNum)
 			if !strings.HasPrefix(fragment, "#") && !strings.HasPrefix(fragment, ";") {
 				// TODO - remove this is backward compatibility glue.
 				fragment = "#" + fragment
 			}
+			return fMatch.URL + fragment
+		}
+
+		for _, m := range f.LineMatches {
 			md := Match{
 				FileName: resolvedPath,
 				LineNum:  m.LineNumber,
-				URL:      fMatch.URL + fragment,
+				URL:      matchURL(m.LineNumber),
 
 				Score:      m.Score,
 				ScoreDebug: m.DebugScore,
@@ -158,7 +176,154 @@ func (s *Server) formatResults(result *zoekt.SearchResult, query string, localPr
 			}
 			fMatch.Matches = append(fMatch.Matches, md)
 		}
+
+		for _, cm := range f.ChunkMatches {
+			for _, md := range chunkLineMatches(cm) {
+				md.FileName = resolvedPath
+				md.URL = matchURL(md.LineNum)
+				fMatch.Matches = append(fMatch.Matches, md)
+			}
+		}
 		fmatches = append(fmatches, &fMatch)
 	}
 	return fmatches, nil
 }
+
+// chunkLineMatches returns a Match for each line of cm with a match. The other
+// lines of the chunk are the context of the match above them, or of the first
+// match if there is none.
+func chunkLineMatches(cm zoekt.ChunkMatch) []Match {
+	lines := strings.SplitAfter(string(cm.Content), "\n")
+	if len(lines) > 1 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var (
+		ms     []Match
+		before strings.Builder
+	)
+	lineStart := cm.ContentStart.ByteOffset
+	for i, line := range lines {
+		lineEnd := lineStart + uint32(len(strings.TrimSuffix(line, "\n")))
+
+		var frags []Fragment
+		last := lineStart
+		for _, r := range cm.Ranges {
+			start, end := max(r.Start.ByteOffset, last), min(r.End.ByteOffset, lineEnd)
+			if start > end || (start == end && r.Start.ByteOffset != r.End.ByteOffset) {
+				continue
+			}
+			frags = append(frags, Fragment{
+				Pre:   line[last-lineStart : start-lineStart],
+				Match: line[start-lineStart : end-lineStart],
+			})
+			last = end
+		}
+
+		if len(frags) == 0 {
+			if len(ms) > 0 {
+				ms[len(ms)-1].After += line
+			} else {
+				before.WriteString(line)
+			}
+			lineStart += uint32(len(line))
+			continue
+		}
+		frags[len(frags)-1].Post = line[last-lineStart:]
+
+		md := Match{
+			Fragments:    frags,
+			Before:       before.String(),
+			SyntaxTokens: lineSyntaxTokens(cm, lineStart, lineEnd),
+		}
+		before.Reset()
+		if !cm.FileName {
+			md.LineNum = int(cm.ContentStart.LineNumber) + i
+		}
+		if uint32(md.LineNum) == cm.BestLineMatch {
+			md.Score = cm.Score
+			md.ScoreDebug = cm.DebugScore
+		}
+		ms = append(ms, md)
+		lineStart += uint32(len(line))
+	}
+	return ms
+}
+
+// lineSyntaxTokens returns the syntax tokens of cm between the file offsets
+// start and end, relative to start.
+func lineSyntaxTokens(cm zoekt.ChunkMatch, start, end uint32) []zoekt.SyntaxToken {
+	start -= cm.ContentStart.ByteOffset
+	end -= cm.ContentStart.ByteOffset
+
+	var toks []zoekt.SyntaxToken
+	for _, t := range cm.SyntaxTokens {
+		if t.End <= start || t.Start >= end {
+			continue
+		}
+		toks = append(toks, zoekt.SyntaxToken{
+			Start: max(t.Start, start) - start,
+			End:   min(t.End, end) - start,
+			Class: t.Class,
+		})
+	}
+	return toks
+}
+
+// highlightFragments renders the fragments of m for the results template.
+// Long text around the matches is cut like LimitPre and LimitPost do, and
+// the syntax tokens of the line are wrapped in spans with a "tok-" class.
+func highlightFragments(m Match) htmltemplate.HTML {
+	var line strings.Builder
+	for _, f := range m.Fragments {
+		line.WriteString(f.Pre)
+		line.WriteString(f.Match)
+		line.WriteString(f.Post)
+	}
+
+	var b strings.Builder
+	write := func(start, end int) {
+		writeSyntaxTokens(&b, line.String(), start, end, m.SyntaxTokens)
+	}
+
+	off := 0
+	for _, f := range m.Fragments {
+		preStart := off
+		if skip := len(f.Pre) - fragmentContextLimit; skip >= 0 {
+			fmt.Fprintf(&b, "...(%d bytes skipped)...", skip)
+			preStart += skip
+		}
+		off += len(f.Pre)
+		write(preStart, off)
+
+		b.WriteString("<b>")
+		write(off, off+len(f.Match))
+		b.WriteString("</b>")
+		off += len(f.Match)
+
+		post := strings.TrimSuffix(f.Post, "\n")
+		if len(post) < fragmentContextLimit {
+			write(off, off+len(post))
+		} else {
+			write(off, off+fragmentContextLimit)
+			fmt.Fprintf(&b, "...(%d bytes skipped)...", len(post)-fragmentContextLimit)
+		}
+		off += len(f.Post)
+	}
+	return htmltemplate.HTML(b.String())
+}
+
+// writeSyntaxTokens writes line[start:end] as HTML, wrapping the parts
+// covered by toks in spans.
+func writeSyntaxTokens(b *strings.Builder, line string, start, end int, toks []zoekt.SyntaxToken) {
+	for _, t := range toks {
+		tStart, tEnd := max(int(t.Start), start), min(int(t.End), end)
+		if tStart >= tEnd {
+			continue
+		}
+		b.WriteString(htmltemplate.HTMLEscapeString(line[start:tStart]))
+		fmt.Fprintf(b, `<span class="tok-%s">%s</span>`, t.Class, htmltemplate.HTMLEscapeString(line[tStart:tEnd]))
+		start = tEnd
+	}
+	b.WriteString(htmltemplate.HTMLEscapeString(line[start:end]))
+}
@@ -100,3 +100,90 @@ func TestPrintDisplaysAbsolutePathForLocalIndex(t *testing.T) {
 		t.Fatalf("print page did not contain absolute path %q: %s", want, body)
 	}
 }
+
+func TestFormatResultsChunkMatches(t *testing.T) {
+	// Line 2 starts at byte 9 and line 3 at byte 20.
+	content := "// start\nfunc a() {\n\treturn \"a\"\n}\n"
+	s := &Server{}
+	result := &zoekt.SearchResult{
+		Files: []zoekt.FileMatch{{
+			FileName:   "a.go",
+			Repository: "repo",
+			ChunkMatches: []zoekt.ChunkMatch{{
+				Content:      []byte(content),
+				ContentStart: zoekt.Location{ByteOffset: 0, LineNumber: 1, Column: 1},
+				Ranges: []zoekt.Range{{
+					Start: zoekt.Location{ByteOffset: 14, LineNumber: 2, Column: 6},
+					End:   zoekt.Location{ByteOffset: 15, LineNumber: 2, Column: 7},
+				}, {
+					Start: zoekt.Location{ByteOffset: 29, LineNumber: 3, Column: 10},
+					End:   zoekt.Location{ByteOffset: 30, LineNumber: 3, Column: 11},
+				}},
+				SyntaxTokens: []zoekt.SyntaxToken{
+					{Start: 0, End: 8, Class: zoekt.TokenComment},
+					{Start: 9, End: 13, Class: zoekt.TokenKeyword},
+					{Start: 14, End: 15, Class: zoekt.TokenIdentifier},
+					{Start: 21, End: 27, Class: zoekt.TokenKeyword},
+					{Start: 28, End: 31, Class: zoekt.TokenString},
+				},
+				BestLineMatch: 3,
+				Score:         42,
+			}},
+		}},
+	}
+
+	fms, err := s.formatResults(result, "a", true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fms) != 1 || len(fms[0].Matches) != 2 {
+		t.Fatalf("unexpected result shape: %+v", fms)
+	}
+
+	ms := fms[0].Matches
+	if ms[0].LineNum != 2 || ms[0].Before != "// start\n" || ms[0].After != "" {
+		t.Errorf("got first match %+v", ms[0])
+	}
+	if ms[1].LineNum != 3 || ms[1].Before != "" || ms[1].After != "}\n" || ms[1].Score != 42 {
+		t.Errorf("got second match %+v", ms[1])
+	}
+
+	for i, want := range []string{
+		`<span class="tok-keyword">func</span> <b><span class="tok-identifier">a</span></b>() {`,
+		"\t" + `<span class="tok-keyword">return</span> <span class="tok-string">&#34;</span><b><span class="tok-string">a</span></b><span class="tok-string">&#34;</span>`,
+	} {
+		if got := string(highlightFragments(ms[i])); got != want {
+			t.Errorf("got line %d\n%s\nwant\n%s", i, got, want)
+		}
+	}
+}
+
+func TestSearchHighlightsSyntax(t *testing.T) {
+	b, err := index.NewShardBuilder(&zoekt.Repository{Name: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Add(index.Document{
+		Name:    "main.go",
+		Content: []byte("package main\n"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	mux, err := NewMux(&Server{
+		Searcher: searcherForTest(t, b),
+		Top:      Top,
+		HTML:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest("GET", "/search?q=main", nil))
+
+	want := `<span class="tok-keyword">package</span> <b><span class="tok-identifier">main</span></b>`
+	if body := rec.Body.String(); !strings.Contains(body, want) {
+		t.Fatalf("search page did not contain %q: %s", want, body)
+	}
+}
@@ -65,6 +65,9 @@ var TemplateText = map[string]string{
      padding: unset;
      overflow: unset;
   }
+  .tok-keyword { color: #a626a4; }
+  .tok-string { color: #50a14f; }
+  .tok-comment { color: #a0a1a7; font-style: italic; }
   .copy-btn {
      margin-left: 2px;
      cursor: pointer;
@@ -514,6 +517,10 @@ var TemplateText = map[string]string{
         <button class="btn btn-primary">Search</button>
       </div>
     </div>
+    <div class="checkbox">
+      <label><input type="checkbox" name="fuzzy" value="true" {{if .FuzzyFileNames}}checked{{end}}> Fuzzy file names</label>
+      <label><input type="checkbox" name="dups" value="true" {{if .CollapseDuplicates}}checked{{end}}> Collapse duplicates</label>
+    </div>
   </div>
 </form>
 `,
@@ -576,6 +583,10 @@ var TemplateText = map[string]string{
             <div class="input-group-addon">Context Lines</div>
             <input class="form-control" id="context" name="ctx" type="number" value="{{.Ctx}}">
           </div>
+          <div class="checkbox">
+            <label><input type="checkbox" name="fuzzy" value="true" {{if .FuzzyFileNames}}checked{{end}}> Fuzzy file names</label>
+            <label><input type="checkbox" name="dups" value="true" {{if .CollapseDuplicates}}checked{{end}}> Collapse duplicates</label>
+          </div>
           <button class="btn btn-primary">Search</button>
           <!--Hack: we use a hidden form field to keep track of the debug flag across searches-->
           {{if .Debug}}<input id="debug" name="debug" type="hidden" value="{{.Debug}}">{{end}}
@@ -717,6 +728,10 @@ document.onkeydown=function(e){
       if (icon) icon.textContent = '►';
     }
   }
+  function toggleAlsoIn(id) {
+    var list = document.getElementById('alsoin-' + id);
+    list.style.display = list.style.display === 'none' ? '' : 'none';
+  }
 </script>
 <body id="results">
   {{template "navbar" .Last}}
@@ -726,7 +741,7 @@ document.onkeydown=function(e){
       {{ $fileCount := len .FileMatches }}
       Found {{.Stats.MatchCount}} results in {{.Stats.FileCount}} files{{if or (lt $fileCount .Stats.FileCount) (or (gt .Stats.ShardsSkipped 0) (gt .Stats.FilesSkipped 0)) }},
         showing top {{ $fileCount }} files (<a rel="nofollow"
-           href="search?q={{.Last.Query}}&num={{More .Last.Num}}">show more</a>).
+           href="search?q={{.Last.Query}}&num={{More .Last.Num}}{{if .Last.FuzzyFileNames}}&fuzzy=true{{end}}{{if .Last.CollapseDuplicates}}&dups=true{{end}}">show more</a>).
       {{else}}.{{end}}
       {{if .QueryStr}}
       <span class="pull-right" style="display: flex; gap: 8px; align-items: center;">
@@ -785,6 +800,14 @@ document.onkeydown=function(e){
       </script>
       {{end}}
     </h5>
+    {{range .Facets}}
+    <div class="small">
+      <b>{{.Name}}:</b>
+      {{range .Values}}
+      {{if .Atom}}<a href="#" onclick="zoektAddQ({{.Atom}}); return false;">{{.Value}}</a>{{else}}{{.Value}}{{end}} ({{.Count}})
+      {{end}}
+    </div>
+    {{end}}
     {{range $i, $f := .FileMatches}}
     <table class="table table-hover table-condensed" style="margin-bottom: 24px;">
       <thead>
@@ -803,7 +826,13 @@ document.onkeydown=function(e){
                        title="restrict search to files written in {{$f.Language}}"
                        onclick="zoektAddQ('lang:&quot;{{$f.Language}}&quot;')" class="label label-primary">language {{$f.Language}}</button></span>{{end}}
                   {{if $f.DuplicateID}}<a class="label label-dup" href="#{{$f.DuplicateID}}">Duplicate result</a>{{end}}
+                  {{if $f.AlsoIn}}<span class="label label-dup" style="cursor:pointer;" onclick="toggleAlsoIn('{{$f.ResultID}}')">Also in {{len $f.AlsoIn}} more</span>{{end}}
                 </small>
+                {{if $f.AlsoIn}}
+                <ul id="alsoin-{{$f.ResultID}}" class="list-unstyled" style="display:none; margin: 4px 0 0 0; font-weight: normal;">
+                  {{range $f.AlsoIn}}<li><small>{{if .URL}}<a href="{{.URL}}">{{end}}{{.Repo}}:{{.FileName}}{{if .URL}}</a>{{end}}</small></li>{{end}}
+                </ul>
+                {{end}}
               </span>
             </span>
           </th>
@@ -816,7 +845,7 @@ document.onkeydown=function(e){
         <tr style="border-top: 1px solid #e0e0e0;">
           <td colspan="2" style="background-color: rgba(238, 238, 255, 0.6); border-top: 1px solid #e0e0e0;">
             <pre class="inline-pre" style="margin-bottom: 0;"><span class="noselect">{{if .URL}}<a href="{{.URL}}">{{end}}<u>{{.LineNum}}</u>{{if .URL}}</a>{{end}}<a href="#" class="copy-btn" title="Copy code link" onclick="copyCodeLink({{JSQuote .FileName}}, {{.LineNum}}); return false;">📋</a>: </span>{{$beforeLines := AddLineNumbers .Before .LineNum true}}{{range $line := $beforeLines}}<span class="noselect"><u>{{$line.LineNum}}</u>:</span> {{$line.Content}}
-{{end}}{{range .Fragments}}{{LimitPre 100 .Pre}}<b>{{.Match}}</b>{{LimitPost 100 (TrimTrailingNewline .Post)}}{{end}}{{$afterLines := AddLineNumbers .After .LineNum false}}{{range $line := $afterLines}}
+{{end}}{{HighlightFragments .}}{{$afterLines := AddLineNumbers .After .LineNum false}}{{range $line := $afterLines}}
 <span class="noselect"><u>{{$line.LineNum}}</u>:</span> {{$line.Content}}{{end}} {{if .ScoreDebug}}<i>({{.ScoreDebug}})</i>{{end}}</pre>
           </td>
         </tr>
@@ -60,6 +60,40 @@ func (s traceAwareSearcher) StreamSearch(
 	return s.Searcher.StreamSearch(ctx, q, opts, sender)
 }
 
+func (s traceAwareSearcher) References(ctx context.Context, symbol string, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	if opts == nil {
+		opts = &zoekt.SearchOptions{}
+	}
+
+	ctx = trace.WithOpenTracingEnabled(ctx, opts.Trace)
+	spanContext := trace.SpanContextFromContext(ctx)
+	if opts.Trace && spanContext != nil {
+		var span opentracing.Span
+		span, ctx = opentracing.StartSpanFromContext(ctx, "zoekt.traceAwareSearcher.References", opentracing.ChildOf(spanContext))
+		defer span.Finish()
+	}
+	return s.Searcher.References(ctx, symbol, opts)
+}
+
+func (s traceAwareSearcher) Explain(ctx context.Context, q query.Q, opts *zoekt.SearchOptions) (*zoekt.Explanation, error) {
+	if opts == nil {
+		opts = &zoekt.SearchOptions{}
+	}
+
+	ctx = trace.WithOpenTracingEnabled(ctx, opts.Trace)
+	spanContext := trace.SpanContextFromContext(ctx)
+	if opts.Trace && spanContext != nil {
+		var span opentracing.Span
+		span, ctx = opentracing.StartSpanFromContext(ctx, "zoekt.traceAwareSearcher.Explain", opentracing.ChildOf(spanContext))
+		defer span.Finish()
+	}
+	return s.Searcher.Explain(ctx, q, opts)
+}
+
+func (s traceAwareSearcher) Clones(ctx context.Context, content []byte, opts *zoekt.CloneOptions) (*zoekt.CloneResult, error) {
+	return s.Searcher.Clones(ctx, content, opts)
+}
+
 func (s traceAwareSearcher) List(ctx context.Context, q query.Q, opts *zoekt.ListOptions) (*zoekt.RepoList, error) {
 	return s.Searcher.List(ctx, q, opts)
 }